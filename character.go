@@ -0,0 +1,257 @@
+// character.go - Character-Aware Profiles for D2R Tracker
+package main
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const characterProfilesFile = "character_profiles.json"
+
+// ========== CHARACTER STRUCTURES ==========
+
+// CharacterInfo describes the character in the current game
+type CharacterInfo struct {
+	Name       string `json:"name"`
+	Class      string `json:"class"`
+	Level      int    `json:"level"`
+	Hardcore   bool   `json:"hardcore"`
+	Ladder     bool   `json:"ladder"`
+	Expansion  bool   `json:"expansion"`
+	FlagsKnown bool   `json:"flags_known"` // Flags come from the offline save file; unknown for online characters
+	Profile    string `json:"profile"`     // Profile used for this character
+}
+
+// CharacterProfileConfig is stored in character_profiles.json next to the executable
+type CharacterProfileConfig struct {
+	AutoSwitch bool              `json:"auto_switch"`        // Switch profiles when a game starts
+	SaveDir    string            `json:"save_dir,omitempty"` // D2R save folder (default: Saved Games\Diablo II Resurrected)
+	Profiles   map[string]string `json:"profiles"`           // Character name -> profile name
+}
+
+// ========== CHARACTER DETECTION ==========
+
+// updateCharacterProfile reads the character once per game and switches to its profile
+func (a *App) updateCharacterProfile() {
+	a.mu.Lock()
+	if a.characterChecked || a.wasInMenu || a.lastGameData.PlayerUnit.Area == 0 || a.lastGameData.PlayerUnit.Name == "" {
+		a.mu.Unlock()
+		return
+	}
+	a.characterChecked = true
+	info := a.readCharacterInfo()
+	target := a.characterProfileFor(info.Name)
+	current := a.currentProfile
+	runActive := a.runActive
+	runStart := a.runStart
+	runLoadoutTag := a.runLoadoutTag
+	if target == "" || target == current {
+		info.Profile = current
+		a.characterInfo = info
+		a.mu.Unlock()
+		return
+	}
+	a.mu.Unlock()
+
+	fmt.Printf("🧙 Character %s (%s, level %d) -> profile %s\n", info.Name, info.Class, info.Level, target)
+	a.SaveCurrentProfile()
+	a.LoadProfile(target)
+
+	a.mu.Lock()
+	// The game that is already running becomes the new profile's current run
+	info.Profile = target
+	a.characterInfo = info
+	a.characterChecked = true
+	a.runActive = runActive
+	a.runStart = runStart
+	a.runLoadoutTag = runLoadoutTag
+	a.xpTracking.CurrentXP = 0 // Take a new XP baseline on the next tick
+	a.mu.Unlock()
+
+	// Create the profile file right away so it shows up in the profile list
+	a.SaveCurrentProfile()
+}
+
+// readCharacterInfo collects name, class and level from memory and the flags from the save file.
+// Must be called with a.mu held.
+func (a *App) readCharacterInfo() CharacterInfo {
+	player := a.lastGameData.PlayerUnit
+	info := CharacterInfo{
+		Name:  player.Name,
+		Class: a.getPlayerClassName(),
+		Level: a.xpTracking.CurrentLevel,
+	}
+
+	hardcore, ladder, expansion, err := readSaveFileFlags(a.getSaveFilePath(player.Name))
+	if err != nil {
+		fmt.Printf("🧙 No save file flags for %s (online character?): %v\n", player.Name, err)
+		return info
+	}
+	info.Hardcore = hardcore
+	info.Ladder = ladder
+	info.Expansion = expansion
+	info.FlagsKnown = true
+	return info
+}
+
+// characterProfileFor returns the profile for a character, or "" when automatic switching is off.
+// Must be called with a.mu held.
+func (a *App) characterProfileFor(character string) string {
+	if !a.characterProfiles.AutoSwitch || character == "" {
+		return ""
+	}
+	if profile, found := a.characterProfiles.Profiles[character]; found && profile != "" {
+		return profile
+	}
+	for name, profile := range a.characterProfiles.Profiles {
+		if strings.EqualFold(name, character) && profile != "" {
+			return profile
+		}
+	}
+	return sanitizeFileName(character)
+}
+
+// readSaveFileFlags reads the status byte of a .d2s save file header
+func readSaveFileFlags(path string) (hardcore, ladder, expansion bool, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, false, false, err
+	}
+	defer file.Close()
+
+	header := make([]byte, 48)
+	if _, err := io.ReadFull(file, header); err != nil {
+		return false, false, false, fmt.Errorf("could not read save header: %v", err)
+	}
+	if binary.LittleEndian.Uint32(header[0:4]) != 0xaa55aa55 {
+		return false, false, false, fmt.Errorf("%s is not a D2 save file", filepath.Base(path))
+	}
+
+	status := header[36]
+	return status&0x04 != 0, status&0x40 != 0, status&0x20 != 0, nil
+}
+
+// getSaveFilePath returns where D2R keeps the offline save of a character. Must be called with a.mu held.
+func (a *App) getSaveFilePath(character string) string {
+	saveDir := a.characterProfiles.SaveDir
+	if saveDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			home = "."
+		}
+		saveDir = filepath.Join(home, "Saved Games", "Diablo II Resurrected")
+	}
+	return filepath.Join(saveDir, character+".d2s")
+}
+
+// ========== CHARACTER PROFILE CONFIG ==========
+
+// loadCharacterProfiles reads character_profiles.json; a missing file enables automatic switching
+func (a *App) loadCharacterProfiles() error {
+	config := CharacterProfileConfig{AutoSwitch: true, Profiles: make(map[string]string)}
+
+	exePath, err := os.Executable()
+	if err != nil {
+		a.characterProfiles = config
+		return fmt.Errorf("could not get executable path: %v", err)
+	}
+	a.characterProfilesPath = filepath.Join(filepath.Dir(exePath), characterProfilesFile)
+
+	data, err := ioutil.ReadFile(a.characterProfilesPath)
+	if os.IsNotExist(err) {
+		a.characterProfiles = config
+		return nil
+	}
+	if err != nil {
+		a.characterProfiles = config
+		return fmt.Errorf("could not read %s: %v", characterProfilesFile, err)
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		a.characterProfiles = CharacterProfileConfig{AutoSwitch: true, Profiles: make(map[string]string)}
+		return fmt.Errorf("could not parse %s: %v", characterProfilesFile, err)
+	}
+	if config.Profiles == nil {
+		config.Profiles = make(map[string]string)
+	}
+	a.characterProfiles = config
+
+	fmt.Printf("✅ Character profiles loaded: %d mappings (auto switch: %v)\n", len(config.Profiles), config.AutoSwitch)
+	return nil
+}
+
+// saveCharacterProfiles writes character_profiles.json. Must be called with a.mu held.
+func (a *App) saveCharacterProfiles() error {
+	if a.characterProfilesPath == "" {
+		return fmt.Errorf("no path for %s", characterProfilesFile)
+	}
+	data, err := json.MarshalIndent(a.characterProfiles, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode character profiles: %v", err)
+	}
+	if err := ioutil.WriteFile(a.characterProfilesPath, data, 0644); err != nil {
+		return fmt.Errorf("could not write %s: %v", a.characterProfilesPath, err)
+	}
+	return nil
+}
+
+// ========== CHARACTER API ==========
+
+func (a *App) GetCharacterInfo() CharacterInfo {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return a.characterInfo
+}
+
+func (a *App) GetCharacterProfileConfig() CharacterProfileConfig {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	config := a.characterProfiles
+	config.Profiles = make(map[string]string, len(a.characterProfiles.Profiles))
+	for name, profile := range a.characterProfiles.Profiles {
+		config.Profiles[name] = profile
+	}
+	return config
+}
+
+// SetCharacterProfile maps a character to a profile; an empty profile removes the mapping
+func (a *App) SetCharacterProfile(character string, profile string) error {
+	character = strings.TrimSpace(character)
+	profile = strings.TrimSpace(profile)
+	if character == "" {
+		return fmt.Errorf("character name cannot be empty")
+	}
+	if strings.ContainsAny(profile, `\/:*?"<>|`) {
+		return fmt.Errorf("invalid characters in profile name")
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if profile == "" {
+		delete(a.characterProfiles.Profiles, character)
+	} else {
+		a.characterProfiles.Profiles[character] = profile
+	}
+	if err := a.saveCharacterProfiles(); err != nil {
+		return err
+	}
+	fmt.Printf("🧙 Character %s mapped to profile %q\n", character, profile)
+	return nil
+}
+
+// SetAutoProfileSwitching turns automatic profile switching on game start on or off
+func (a *App) SetAutoProfileSwitching(enabled bool) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.characterProfiles.AutoSwitch = enabled
+	return a.saveCharacterProfiles()
+}
@@ -0,0 +1,218 @@
+// charms.go - Charm & Jewel Tracking for D2R Tracker
+package main
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+// ========== CHARM STRUCTURES ==========
+
+// CharmInfo is attached to picked-up charms and jewels
+type CharmInfo struct {
+	Kind  string   `json:"kind"`            // Small Charm, Large Charm, Grand Charm or Jewel
+	Class string   `json:"class"`           // e.g. "Annihilus", "Skiller", "Life + FHR", "ED + IAS"
+	Rolls []string `json:"rolls"`           // Readable item stats
+	Score float64  `json:"score,omitempty"` // Jewel ranking, 0-100
+}
+
+type CharmStats struct {
+	Charms    int            `json:"charms"`
+	Jewels    int            `json:"jewels"`
+	ByClass   map[string]int `json:"by_class"`
+	Uniques   []CharmDrop    `json:"uniques"`    // Annihilus, Hellfire Torch, Gheed's Fortune, Rainbow Facet; newest first
+	TopJewels []CharmDrop    `json:"top_jewels"` // Best jewel score first
+	RunTypes  []RunTypeDrops `json:"run_types"`  // Drops per charm class
+}
+
+type CharmDrop struct {
+	ItemID  string    `json:"item_id"`
+	Name    string    `json:"name"`
+	Quality string    `json:"quality"`
+	Time    time.Time `json:"time"`
+	RunType string    `json:"run_type"`
+	Charm   CharmInfo `json:"charm"`
+}
+
+const maxTopJewels = 10
+
+var uniqueCharmNames = map[item.Name]string{
+	"SmallCharm": "Annihilus",
+	"LargeCharm": "Hellfire Torch",
+	"GrandCharm": "Gheed's Fortune",
+	"Jewel":      "Rainbow Facet",
+}
+
+// jewelRollMaximums are the best rolls a jewel can have; a perfect roll adds 25 points to the score
+var jewelRollMaximums = map[stat.ID]float64{
+	stat.EnhancedDamage:       40,
+	stat.IncreasedAttackSpeed: 15,
+	stat.FasterHitRecovery:    7,
+	stat.MaxDamage:            30,
+	stat.Strength:             9,
+	stat.Dexterity:            9,
+}
+
+// ========== CHARM CLASSIFICATION ==========
+
+// classifyCharm returns nil for items that are not charms or jewels
+func (a *App) classifyCharm(itm data.Item) *CharmInfo {
+	var kind string
+	switch itm.Name {
+	case "SmallCharm", "LargeCharm", "GrandCharm", "Jewel":
+		kind = a.beautifyItemName(string(itm.Name))
+	default:
+		return nil
+	}
+
+	info := &CharmInfo{Kind: kind, Rolls: describeItemStats(itm)}
+	if itm.Quality == item.QualityUnique {
+		info.Class = uniqueCharmNames[itm.Name]
+		if itm.IsNamed && itm.IdentifiedName != "" {
+			info.Class = itm.IdentifiedName
+		}
+	} else {
+		info.Class = charmClass(itm)
+	}
+	if itm.Name == "Jewel" {
+		info.Score = jewelScore(itm)
+	}
+	return info
+}
+
+// charmClass names the useful affixes of a magic or rare charm or jewel
+func charmClass(itm data.Item) string {
+	var features []string
+	has := func(id stat.ID) bool {
+		_, found := itm.FindStat(id, 0)
+		return found
+	}
+
+	// Skill tab stats use the tab as layer
+	if hasStatAnyLayer(itm, stat.AddSkillTab) {
+		features = append(features, "Skiller")
+	}
+	if has(stat.EnhancedDamage) {
+		features = append(features, "ED")
+	}
+	if has(stat.IncreasedAttackSpeed) {
+		features = append(features, "IAS")
+	}
+	if has(stat.MaxLife) {
+		features = append(features, "Life")
+	}
+	if has(stat.FasterHitRecovery) {
+		features = append(features, "FHR")
+	}
+	if has(stat.MagicFind) {
+		features = append(features, "MF")
+	}
+	if allResist(itm) > 0 {
+		features = append(features, "All Res")
+	} else if has(stat.FireResist) || has(stat.LightningResist) || has(stat.ColdResist) || has(stat.PoisonResist) {
+		features = append(features, "Resist")
+	}
+
+	if len(features) == 0 {
+		return "Other"
+	}
+	return strings.Join(features, " + ")
+}
+
+// jewelScore rates a jewel by how close its best rolls are to the maximum
+func jewelScore(itm data.Item) float64 {
+	score := 0.0
+	for id, maximum := range jewelRollMaximums {
+		if s, found := itm.FindStat(id, 0); found {
+			score += float64(s.Value) / maximum * 25
+		}
+	}
+	score += float64(allResist(itm)) / 15 * 25
+	if score > 100 {
+		score = 100
+	}
+	return score
+}
+
+// allResist is the lowest of the four resistances; 0 unless the item has all of them
+func allResist(itm data.Item) int {
+	lowest := 0
+	for i, id := range []stat.ID{stat.FireResist, stat.LightningResist, stat.ColdResist, stat.PoisonResist} {
+		s, found := itm.FindStat(id, 0)
+		if !found {
+			return 0
+		}
+		if i == 0 || s.Value < lowest {
+			lowest = s.Value
+		}
+	}
+	return lowest
+}
+
+func hasStatAnyLayer(itm data.Item, id stat.ID) bool {
+	for _, s := range itm.Stats {
+		if s.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// ========== CHARM API ==========
+
+func (a *App) GetCharmStats() CharmStats {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	stats := CharmStats{
+		ByClass:   make(map[string]int),
+		Uniques:   []CharmDrop{},
+		TopJewels: []CharmDrop{},
+	}
+
+	for i := len(a.itemHistory) - 1; i >= 0; i-- {
+		entry := a.itemHistory[i]
+		if entry.Charm == nil {
+			continue
+		}
+		drop := CharmDrop{
+			ItemID:  entry.ID,
+			Name:    entry.Name,
+			Quality: entry.Quality,
+			Time:    entry.Time,
+			RunType: entry.RunType,
+			Charm:   *entry.Charm,
+		}
+
+		if drop.Charm.Kind == "Jewel" {
+			stats.Jewels++
+			stats.TopJewels = append(stats.TopJewels, drop)
+		} else {
+			stats.Charms++
+		}
+		stats.ByClass[drop.Charm.Class]++
+		if entry.Quality == "Unique" {
+			stats.Uniques = append(stats.Uniques, drop)
+		}
+	}
+
+	sort.SliceStable(stats.TopJewels, func(i, j int) bool {
+		return stats.TopJewels[i].Charm.Score > stats.TopJewels[j].Charm.Score
+	})
+	if len(stats.TopJewels) > maxTopJewels {
+		stats.TopJewels = stats.TopJewels[:maxTopJewels]
+	}
+
+	stats.RunTypes = a.dropsPerRunType(func(entry ItemEntry) string {
+		if entry.Charm == nil {
+			return ""
+		}
+		return entry.Charm.Class
+	})
+	return stats
+}
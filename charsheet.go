@@ -0,0 +1,196 @@
+// charsheet.go - Character Sheet Snapshots for D2R Tracker
+package main
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+// Runs are grouped into magic find brackets of this size
+const mfBracketSize = 100
+
+// ========== CHARACTER SHEET STRUCTURES ==========
+
+// CharacterSnapshot is the character sheet at the start of a run
+type CharacterSnapshot struct {
+	Time      time.Time `json:"time"`
+	RunIndex  int       `json:"run_index"`
+	Character string    `json:"character"`
+	Class     string    `json:"class"`
+	Level     int       `json:"level"`
+	// Attributes
+	Strength  int `json:"strength"`
+	Dexterity int `json:"dexterity"`
+	Vitality  int `json:"vitality"`
+	Energy    int `json:"energy"`
+	MaxLife   int `json:"max_life"`
+	MaxMana   int `json:"max_mana"`
+	// Find and speed
+	MagicFind int `json:"magic_find"`
+	GoldFind  int `json:"gold_find"`
+	FCR       int `json:"fcr"`
+	FHR       int `json:"fhr"`
+	FRW       int `json:"frw"`
+	IAS       int `json:"ias"`
+	// Resistances as shown on the character sheet in each difficulty
+	Resistances map[string]Resistances `json:"resistances"` // "Normal", "Nightmare", "Hell"
+}
+
+type Resistances struct {
+	Fire      int `json:"fire"`
+	Cold      int `json:"cold"`
+	Lightning int `json:"lightning"`
+	Poison    int `json:"poison"`
+}
+
+// MFBracket compares drops of runs within a magic find range
+type MFBracket struct {
+	MinMF          int     `json:"min_mf"`
+	MaxMF          int     `json:"max_mf"`
+	Runs           int     `json:"runs"`
+	Uniques        int     `json:"uniques"`
+	Sets           int     `json:"sets"`
+	UniquesPerRun  float64 `json:"uniques_per_run"`
+	SetsPerRun     float64 `json:"sets_per_run"`
+	AverageMF      float64 `json:"average_mf"`
+	AverageMinutes float64 `json:"average_minutes"`
+}
+
+// difficultyResistPenalties are subtracted from resistances in each difficulty
+var difficultyResistPenalties = []struct {
+	name    string
+	penalty int
+}{
+	{"Normal", 0},
+	{"Nightmare", 40},
+	{"Hell", 100},
+}
+
+// ========== SNAPSHOT CAPTURE ==========
+
+// updateCharacterSnapshot takes the character sheet once per run, as soon as the stats are readable
+func (a *App) updateCharacterSnapshot() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	player := a.lastGameData.PlayerUnit
+	if a.runSnapshot != nil || !a.runActive || a.wasInMenu || player.Area == 0 || player.Name == "" {
+		return
+	}
+	if maxLife, found := player.FindStat(stat.MaxLife, 0); !found || maxLife.Value <= 0 {
+		return
+	}
+
+	value := func(id stat.ID) int {
+		s, _ := player.FindStat(id, 0)
+		return s.Value
+	}
+	resist := func(id stat.ID, maxID stat.ID, penalty int) int {
+		resistance := value(id) - penalty
+		if maximum := 75 + value(maxID); resistance > maximum {
+			resistance = maximum
+		}
+		return resistance
+	}
+
+	snapshot := &CharacterSnapshot{
+		Time:        time.Now(),
+		RunIndex:    a.currentRun,
+		Character:   player.Name,
+		Class:       a.getPlayerClassName(),
+		Level:       value(stat.Level),
+		Strength:    value(stat.Strength),
+		Dexterity:   value(stat.Dexterity),
+		Vitality:    value(stat.Vitality),
+		Energy:      value(stat.Energy),
+		MaxLife:     value(stat.MaxLife),
+		MaxMana:     value(stat.MaxMana),
+		MagicFind:   value(stat.MagicFind),
+		GoldFind:    value(stat.GoldFind),
+		FCR:         value(stat.FasterCastRate),
+		FHR:         value(stat.FasterHitRecovery),
+		FRW:         value(stat.FasterRunWalk),
+		IAS:         value(stat.IncreasedAttackSpeed),
+		Resistances: make(map[string]Resistances, len(difficultyResistPenalties)),
+	}
+	for _, difficulty := range difficultyResistPenalties {
+		snapshot.Resistances[difficulty.name] = Resistances{
+			Fire:      resist(stat.FireResist, stat.MaxFireResist, difficulty.penalty),
+			Cold:      resist(stat.ColdResist, stat.MaxColdResist, difficulty.penalty),
+			Lightning: resist(stat.LightningResist, stat.MaxLightningResist, difficulty.penalty),
+			Poison:    resist(stat.PoisonResist, stat.MaxPoisonResist, difficulty.penalty),
+		}
+	}
+	a.runSnapshot = snapshot
+}
+
+// ========== CHARACTER SHEET API ==========
+
+// GetCharacterSnapshots returns the snapshots of all runs, oldest first; an empty character returns every character
+func (a *App) GetCharacterSnapshots(character string) []CharacterSnapshot {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	snapshots := make([]CharacterSnapshot, 0)
+	for _, record := range a.runRecords {
+		if record.Character == nil || (character != "" && record.Character.Character != character) {
+			continue
+		}
+		snapshots = append(snapshots, *record.Character)
+	}
+	return snapshots
+}
+
+// GetMagicFindStats compares unique and set drops per run between magic find brackets
+func (a *App) GetMagicFindStats() []MFBracket {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	uniques := make(map[int]int)
+	sets := make(map[int]int)
+	for _, entry := range a.itemHistory {
+		switch entry.Quality {
+		case "Unique":
+			uniques[entry.RunIndex]++
+		case "Set":
+			sets[entry.RunIndex]++
+		}
+	}
+
+	brackets := make(map[int]*MFBracket)
+	mfSum := make(map[int]int)
+	durationSum := make(map[int]int64)
+	for _, record := range a.runRecords {
+		if record.Character == nil {
+			continue
+		}
+		mf := record.Character.MagicFind
+		if mf < 0 {
+			mf = 0
+		}
+		key := mf / mfBracketSize
+		bracket, found := brackets[key]
+		if !found {
+			bracket = &MFBracket{MinMF: key * mfBracketSize, MaxMF: key*mfBracketSize + mfBracketSize - 1}
+			brackets[key] = bracket
+		}
+		bracket.Runs++
+		bracket.Uniques += uniques[record.Index]
+		bracket.Sets += sets[record.Index]
+		mfSum[key] += record.Character.MagicFind
+		durationSum[key] += record.DurationMs
+	}
+
+	result := make([]MFBracket, 0, len(brackets))
+	for key, bracket := range brackets {
+		bracket.UniquesPerRun = float64(bracket.Uniques) / float64(bracket.Runs)
+		bracket.SetsPerRun = float64(bracket.Sets) / float64(bracket.Runs)
+		bracket.AverageMF = float64(mfSum[key]) / float64(bracket.Runs)
+		bracket.AverageMinutes = (time.Duration(durationSum[key]/int64(bracket.Runs)) * time.Millisecond).Minutes()
+		result = append(result, *bracket)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].MinMF < result[j].MinMF })
+	return result
+}
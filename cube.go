@@ -0,0 +1,264 @@
+// cube.go - Horadric Cube Transmutation Log for D2R Tracker
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+// Outputs of one transmute may show up a few ticks after the inputs vanished
+const transmuteSettleTime = 400 * time.Millisecond
+
+// ========== CUBE STRUCTURES ==========
+
+// CubeTransmute is one press of the Transmute button with the consumed and created items.
+// Recipes: rune upgrade, gem upgrade, crafting, reroll, socketing, token of absolution and other.
+type CubeTransmute struct {
+	Time     time.Time  `json:"time"`
+	RunIndex int        `json:"run_index"`
+	Area     string     `json:"area,omitempty"`
+	Recipe   string     `json:"recipe"`
+	Inputs   []CubeItem `json:"inputs"`
+	Outputs  []CubeItem `json:"outputs"`
+}
+
+type CubeItem struct {
+	Name     string `json:"name"`
+	Quality  string `json:"quality"`
+	Ethereal bool   `json:"ethereal,omitempty"`
+	Sockets  int    `json:"sockets,omitempty"`
+}
+
+type CubeStats struct {
+	Transmutes   int            `json:"transmutes"`
+	ByRecipe     map[string]int `json:"by_recipe"`
+	RunesSpent   map[string]int `json:"runes_spent"`   // Rune name -> runes used as input
+	RunesCreated map[string]int `json:"runes_created"` // Rune name -> runes created by upgrades
+}
+
+// pendingTransmute collects a transmute until its outputs have settled
+type pendingTransmute struct {
+	started time.Time
+	inputs  []data.Item
+	outputs []data.Item
+}
+
+// ========== TRANSMUTE DETECTION ==========
+
+// updateCubeTracking compares the cube contents between ticks while the cube is open.
+// Cube items that vanish from memory were consumed; new units in the cube were created.
+func (a *App) updateCubeTracking() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	gameData := a.lastGameData
+	if a.wasInMenu || gameData.PlayerUnit.Area == 0 || len(gameData.Inventory.AllItems) == 0 {
+		return
+	}
+
+	units := make(map[data.UnitID]bool, len(gameData.Inventory.AllItems))
+	cubeItems := make(map[data.UnitID]data.Item)
+	for _, itm := range gameData.Inventory.AllItems {
+		units[itm.UnitID] = true
+		if itm.Location.LocationType == item.LocationCube {
+			cubeItems[itm.UnitID] = itm
+		}
+	}
+
+	// The cube closing on the same tick as a transmute still counts
+	cubeOpen := gameData.OpenMenus.Cube || a.cubeWasOpen
+	if a.cubeItems != nil && cubeOpen {
+		for unitID, itm := range a.cubeItems {
+			if units[unitID] {
+				continue
+			}
+			if a.pendingTransmute == nil {
+				a.pendingTransmute = &pendingTransmute{started: time.Now()}
+			}
+			a.pendingTransmute.inputs = append(a.pendingTransmute.inputs, itm)
+		}
+		if a.pendingTransmute != nil {
+			for unitID, itm := range cubeItems {
+				if !a.cubeKnownUnits[unitID] {
+					a.pendingTransmute.outputs = append(a.pendingTransmute.outputs, itm)
+				}
+			}
+		}
+	}
+
+	if a.pendingTransmute != nil && time.Since(a.pendingTransmute.started) >= transmuteSettleTime {
+		a.finishTransmute()
+	}
+
+	a.cubeItems = cubeItems
+	a.cubeKnownUnits = units
+	a.cubeWasOpen = gameData.OpenMenus.Cube
+}
+
+// finishTransmute classifies and stores the pending transmute. Must be called with a.mu held.
+func (a *App) finishTransmute() {
+	pending := a.pendingTransmute
+	a.pendingTransmute = nil
+	if pending == nil || len(pending.inputs) == 0 {
+		return
+	}
+
+	transmute := CubeTransmute{
+		Time:     pending.started,
+		RunIndex: a.currentRun,
+		Area:     a.getCurrentAreaName(),
+		Recipe:   classifyTransmute(pending.inputs, pending.outputs),
+		Inputs:   a.toCubeItems(pending.inputs),
+		Outputs:  a.toCubeItems(pending.outputs),
+	}
+	a.transmuteHistory = append(a.transmuteHistory, transmute)
+
+	fmt.Printf("⚗️ TRANSMUTE (%s): %s -> %s\n", transmute.Recipe,
+		describeCubeItems(transmute.Inputs), describeCubeItems(transmute.Outputs))
+}
+
+// resetCubeTracking forgets unit IDs, which are only valid within one game. Must be called with a.mu held.
+func (a *App) resetCubeTracking() {
+	a.pendingTransmute = nil
+	a.cubeItems = nil
+	a.cubeKnownUnits = nil
+	a.cubeWasOpen = false
+}
+
+// ========== RECIPE CLASSIFICATION ==========
+
+func classifyTransmute(inputs []data.Item, outputs []data.Item) string {
+	for _, out := range outputs {
+		if out.Name == "TokenofAbsolution" {
+			return "token of absolution"
+		}
+	}
+	if len(outputs) != 1 {
+		return "other"
+	}
+	out := outputs[0]
+
+	switch {
+	case isRuneItem(out) && allItems(inputs, func(itm data.Item) bool { return isRuneItem(itm) || isGemItem(itm) }):
+		return "rune upgrade"
+	case isGemItem(out) && allItems(inputs, isGemItem):
+		return "gem upgrade"
+	case out.Quality == item.QualityCrafted:
+		return "crafting"
+	}
+
+	// Socketing and rerolls return the same base that was put into the cube
+	for _, in := range inputs {
+		if in.Name != out.Name || isRuneItem(in) || isGemItem(in) {
+			continue
+		}
+		if itemSockets(out) > itemSockets(in) {
+			return "socketing"
+		}
+		if out.Quality >= item.QualityMagic {
+			return "reroll"
+		}
+	}
+	return "other"
+}
+
+func isRuneItem(itm data.Item) bool {
+	return itm.Type().Code == item.TypeRune || strings.HasSuffix(string(itm.Name), "Rune")
+}
+
+func isGemItem(itm data.Item) bool {
+	switch itm.Type().Code {
+	case item.TypeAmethyst, item.TypeDiamond, item.TypeEmerald, item.TypeRuby,
+		item.TypeSapphire, item.TypeTopaz, item.TypeSkull:
+		return true
+	}
+	return false
+}
+
+func allItems(items []data.Item, match func(data.Item) bool) bool {
+	for _, itm := range items {
+		if !match(itm) {
+			return false
+		}
+	}
+	return len(items) > 0
+}
+
+func itemSockets(itm data.Item) int {
+	sockets, _ := itm.FindStat(stat.NumSockets, 0)
+	return sockets.Value
+}
+
+// ========== CUBE API ==========
+
+// GetTransmuteHistory returns the newest transmutes first; limit <= 0 returns all
+func (a *App) GetTransmuteHistory(limit int) []CubeTransmute {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	transmutes := make([]CubeTransmute, 0, len(a.transmuteHistory))
+	for i := len(a.transmuteHistory) - 1; i >= 0; i-- {
+		if limit > 0 && len(transmutes) >= limit {
+			break
+		}
+		transmutes = append(transmutes, a.transmuteHistory[i])
+	}
+	return transmutes
+}
+
+func (a *App) GetCubeStats() CubeStats {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	stats := CubeStats{
+		ByRecipe:     make(map[string]int),
+		RunesSpent:   make(map[string]int),
+		RunesCreated: make(map[string]int),
+	}
+	for _, transmute := range a.transmuteHistory {
+		stats.Transmutes++
+		stats.ByRecipe[transmute.Recipe]++
+		for _, in := range transmute.Inputs {
+			if strings.HasSuffix(in.Name, " Rune") {
+				stats.RunesSpent[in.Name]++
+			}
+		}
+		for _, out := range transmute.Outputs {
+			if strings.HasSuffix(out.Name, " Rune") {
+				stats.RunesCreated[out.Name]++
+			}
+		}
+	}
+	return stats
+}
+
+// ========== CUBE HELPERS ==========
+
+func (a *App) toCubeItems(items []data.Item) []CubeItem {
+	cubeItems := make([]CubeItem, 0, len(items))
+	for _, itm := range items {
+		cubeItems = append(cubeItems, CubeItem{
+			Name:     a.getItemName(itm),
+			Quality:  a.getItemQuality(itm),
+			Ethereal: itm.Ethereal,
+			Sockets:  itemSockets(itm),
+		})
+	}
+	return cubeItems
+}
+
+func describeCubeItems(items []CubeItem) string {
+	if len(items) == 0 {
+		return "nothing"
+	}
+	names := make([]string, 0, len(items))
+	for _, itm := range items {
+		names = append(names, itm.Name)
+	}
+	return strings.Join(names, " + ")
+}
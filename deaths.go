@@ -0,0 +1,180 @@
+// deaths.go - Death & XP Loss Tracking for D2R Tracker
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data/mode"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+const (
+	// XP and gold losses seen this long before or after a death belong to it
+	deathLossWindow = 15 * time.Second
+	// A corpse showing up this soon after a detected death is the same death
+	deathCorpseGrace = 10 * time.Second
+)
+
+// ========== DEATH STRUCTURES ==========
+
+type DeathRecord struct {
+	Time      time.Time `json:"time"`
+	RunIndex  int       `json:"run_index"`
+	Area      string    `json:"area,omitempty"`
+	Character string    `json:"character,omitempty"`
+	Level     int       `json:"level"`
+	XPLost    int64     `json:"xp_lost"`
+	GoldLost  int       `json:"gold_lost"`
+	Detection string    `json:"detection"` // "dead mode", "zero life" or "corpse"
+}
+
+type DeathStats struct {
+	TotalDeaths     int            `json:"total_deaths"`
+	SessionDeaths   int            `json:"session_deaths"`
+	TotalXPLost     int64          `json:"total_xp_lost"`
+	SessionXPLost   int64          `json:"session_xp_lost"`
+	TotalGoldLost   int            `json:"total_gold_lost"`
+	SessionGoldLost int            `json:"session_gold_lost"`
+	GrossXPPerHour  float64        `json:"gross_xp_per_hour"` // Session XP gained per hour
+	NetXPPerHour    float64        `json:"net_xp_per_hour"`   // Session XP gained minus XP lost per hour
+	ByArea          map[string]int `json:"by_area"`
+}
+
+// ========== DEATH DETECTION ==========
+
+// updateDeathTracking watches the player for the dead mode, zero life or a new own corpse
+func (a *App) updateDeathTracking() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	gameData := a.lastGameData
+	if a.wasInMenu || gameData.PlayerUnit.Area == 0 {
+		return
+	}
+
+	dead := gameData.PlayerUnit.Mode == mode.Dead
+	zeroLife := false
+	if life, found := gameData.PlayerUnit.FindStat(stat.Life, 0); found {
+		maxLife, _ := gameData.PlayerUnit.FindStat(stat.MaxLife, 0)
+		zeroLife = life.Value <= 0 && maxLife.Value > 0
+	}
+	corpse := gameData.Corpse.Found
+
+	wasDead := a.deathWasDead
+	hadCorpse := a.deathHadCorpse
+	sampled := a.deathSampled
+	a.deathWasDead = dead || zeroLife
+	a.deathHadCorpse = corpse
+	a.deathSampled = true
+
+	// A corpse from before the tracker joined the game is not a new death
+	if !sampled {
+		return
+	}
+
+	switch {
+	case dead && !wasDead:
+		a.recordDeath("dead mode")
+	case zeroLife && !wasDead:
+		a.recordDeath("zero life")
+	case corpse && !hadCorpse && time.Since(a.lastDeath) > deathCorpseGrace:
+		a.recordDeath("corpse")
+	}
+}
+
+// recordDeath stores a new death and takes over recent unassigned losses. Must be called with a.mu held.
+func (a *App) recordDeath(detection string) {
+	now := time.Now()
+	record := DeathRecord{
+		Time:      now,
+		RunIndex:  a.currentRun,
+		Area:      a.getCurrentAreaName(),
+		Character: a.lastGameData.PlayerUnit.Name,
+		Level:     a.xpTracking.CurrentLevel,
+		Detection: detection,
+	}
+	if now.Sub(a.pendingDeathLossAt) <= deathLossWindow {
+		record.XPLost = a.pendingDeathXP
+		record.GoldLost = a.pendingDeathGold
+	}
+	a.pendingDeathXP = 0
+	a.pendingDeathGold = 0
+
+	a.deathHistory = append(a.deathHistory, record)
+	a.lastDeath = now
+	a.xpTracking.SessionDeaths++
+
+	fmt.Printf("💀 DEATH in %s (Run #%d, Level %d, detected by %s)\n", record.Area, record.RunIndex, record.Level, detection)
+}
+
+// addDeathLoss assigns lost XP or gold to the latest death, or keeps it until a death is detected.
+// Must be called with a.mu held.
+func (a *App) addDeathLoss(xp int64, gold int) {
+	if last := len(a.deathHistory) - 1; last >= 0 && time.Since(a.lastDeath) <= deathLossWindow {
+		a.deathHistory[last].XPLost += xp
+		a.deathHistory[last].GoldLost += gold
+		fmt.Printf("💀 Death penalty: -%d XP, -%d gold\n", xp, gold)
+		return
+	}
+	if time.Since(a.pendingDeathLossAt) > deathLossWindow {
+		a.pendingDeathXP = 0
+		a.pendingDeathGold = 0
+	}
+	a.pendingDeathXP += xp
+	a.pendingDeathGold += gold
+	a.pendingDeathLossAt = time.Now()
+}
+
+// recentDeath reports whether the player died a moment ago. Must be called with a.mu held.
+func (a *App) recentDeath() bool {
+	return !a.lastDeath.IsZero() && time.Since(a.lastDeath) <= deathLossWindow
+}
+
+// resetDeathTracking starts sampling the player again in a new game. Must be called with a.mu held.
+func (a *App) resetDeathTracking() {
+	a.deathSampled = false
+	a.deathWasDead = false
+	a.deathHadCorpse = false
+}
+
+// ========== DEATH API ==========
+
+// GetDeathHistory returns the newest deaths first; limit <= 0 returns all
+func (a *App) GetDeathHistory(limit int) []DeathRecord {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	deaths := make([]DeathRecord, 0, len(a.deathHistory))
+	for i := len(a.deathHistory) - 1; i >= 0; i-- {
+		if limit > 0 && len(deaths) >= limit {
+			break
+		}
+		deaths = append(deaths, a.deathHistory[i])
+	}
+	return deaths
+}
+
+// GetDeathStats summarizes deaths, their penalties and gross versus net XP per hour
+func (a *App) GetDeathStats() DeathStats {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	stats := DeathStats{
+		SessionXPLost:  a.xpTracking.SessionXPLost,
+		GrossXPPerHour: a.xpTracking.XPPerHour,
+		NetXPPerHour:   a.xpTracking.NetXPPerHour,
+		ByArea:         make(map[string]int),
+	}
+	for _, death := range a.deathHistory {
+		stats.TotalDeaths++
+		stats.TotalXPLost += death.XPLost
+		stats.TotalGoldLost += death.GoldLost
+		stats.ByArea[death.Area]++
+		if !death.Time.Before(a.sessionStartTime) {
+			stats.SessionDeaths++
+			stats.SessionGoldLost += death.GoldLost
+		}
+	}
+	return stats
+}
@@ -0,0 +1,387 @@
+// equipment.go - Equipment Change Log & Loadouts for D2R Tracker
+package main
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+)
+
+// Swaps pass through the cursor; equipment must be unchanged this long before a change is logged
+const equipmentSettleTime = 2 * time.Second
+
+// ========== EQUIPMENT STRUCTURES ==========
+
+// EquipmentLog is stored per profile
+type EquipmentLog struct {
+	Equipped map[string]map[string]EquippedItem `json:"equipped"` // Character -> slot -> item
+	Changes  []EquipmentChange                  `json:"changes"`
+	Loadouts []Loadout                          `json:"loadouts"`
+}
+
+type EquippedItem struct {
+	Name     string `json:"name"`
+	Quality  string `json:"quality"`
+	Ethereal bool   `json:"ethereal,omitempty"`
+}
+
+// EquipmentChange is one slot that changed; Before or After is nil for an empty slot
+type EquipmentChange struct {
+	Time      time.Time     `json:"time"`
+	RunIndex  int           `json:"run_index"`
+	Area      string        `json:"area,omitempty"`
+	Character string        `json:"character"`
+	Owner     string        `json:"owner"` // "player" or "merc"
+	Slot      string        `json:"slot"`  // Body location, e.g. "head", "left_arm_secondary"
+	Before    *EquippedItem `json:"before,omitempty"`
+	After     *EquippedItem `json:"after,omitempty"`
+}
+
+// Loadout is a full set of player and merc gear
+type Loadout struct {
+	ID        string                  `json:"id"`
+	Character string                  `json:"character"`
+	Name      string                  `json:"name,omitempty"` // Set by the user, e.g. "MF swap"
+	FirstSeen time.Time               `json:"first_seen"`
+	Items     map[string]EquippedItem `json:"items"` // "player:head", "merc:torso", ...
+}
+
+type LoadoutStats struct {
+	ID             string                  `json:"id"`
+	Character      string                  `json:"character"`
+	Name           string                  `json:"name,omitempty"`
+	Items          map[string]EquippedItem `json:"items"`
+	Runs           int                     `json:"runs"`
+	AverageMinutes float64                 `json:"average_minutes"`
+	Uniques        int                     `json:"uniques"`
+	Sets           int                     `json:"sets"`
+	UniquesPerRun  float64                 `json:"uniques_per_run"`
+	SetsPerRun     float64                 `json:"sets_per_run"`
+	XPPerMinute    float64                 `json:"xp_per_minute"`
+	Current        bool                    `json:"current"` // Worn right now
+}
+
+// ========== EQUIPMENT TRACKING ==========
+
+func (a *App) updateEquipmentTracking() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now()
+	elapsed := now.Sub(a.lastEquipmentTick)
+	a.lastEquipmentTick = now
+
+	gameData := a.lastGameData
+	character := gameData.PlayerUnit.Name
+	if a.wasInMenu || !a.runActive || gameData.PlayerUnit.Area == 0 || character == "" || len(gameData.Inventory.AllItems) == 0 {
+		return
+	}
+
+	committed, known := a.equipmentLog.Equipped[character]
+	current := a.readEquipment(gameData)
+	if !gameData.HasMerc {
+		// A dead merc's gear is not readable; keep the last known merc gear
+		for slot, equipped := range committed {
+			if strings.HasPrefix(slot, "merc:") {
+				current[slot] = equipped
+			}
+		}
+	}
+
+	switch {
+	case !known:
+		a.equipmentLog.Equipped[character] = current
+	case sameEquipment(current, committed):
+		a.pendingEquipment = nil
+	case a.pendingEquipment == nil || !sameEquipment(current, a.pendingEquipment):
+		a.pendingEquipment = current
+		a.pendingEquipmentSince = now
+	case now.Sub(a.pendingEquipmentSince) >= equipmentSettleTime:
+		a.recordEquipmentChanges(character, committed, current)
+		a.equipmentLog.Equipped[character] = current
+		a.pendingEquipment = nil
+	}
+
+	// Time per loadout in the active run; the longest one becomes the run's loadout
+	if elapsed <= 0 || elapsed > time.Second {
+		elapsed = 0 // Long gaps are menus or loading screens
+	}
+	loadoutID := a.ensureLoadout(character, a.equipmentLog.Equipped[character])
+	a.runLoadoutTime[loadoutID] += elapsed
+}
+
+// readEquipment lists the player's and merc's equipped items by slot. Must be called with a.mu held.
+func (a *App) readEquipment(gameData data.Data) map[string]EquippedItem {
+	equipment := make(map[string]EquippedItem)
+	for _, itm := range gameData.Inventory.AllItems {
+		var owner string
+		switch itm.Location.LocationType {
+		case item.LocationEquipped:
+			owner = "player"
+		case item.LocationMercenary:
+			owner = "merc"
+		default:
+			continue
+		}
+		name := a.displayItemName(itm.Name)
+		if itm.IsNamed && itm.IdentifiedName != "" {
+			name = itm.IdentifiedName
+		}
+		equipment[owner+":"+string(itm.Location.BodyLocation)] = EquippedItem{
+			Name:     name,
+			Quality:  a.getItemQuality(itm),
+			Ethereal: itm.Ethereal,
+		}
+	}
+	return equipment
+}
+
+// recordEquipmentChanges logs every slot that differs. Must be called with a.mu held.
+func (a *App) recordEquipmentChanges(character string, before, after map[string]EquippedItem) {
+	slots := make(map[string]bool)
+	for slot := range before {
+		slots[slot] = true
+	}
+	for slot := range after {
+		slots[slot] = true
+	}
+	sorted := make([]string, 0, len(slots))
+	for slot := range slots {
+		sorted = append(sorted, slot)
+	}
+	sort.Strings(sorted)
+
+	now := time.Now()
+	for _, slot := range sorted {
+		removed, hadOld := before[slot]
+		added, hasNew := after[slot]
+		if hadOld == hasNew && removed == added {
+			continue
+		}
+		owner, bodyLocation, _ := strings.Cut(slot, ":")
+		change := EquipmentChange{
+			Time:      now,
+			RunIndex:  a.currentRun,
+			Area:      a.getCurrentAreaName(),
+			Character: character,
+			Owner:     owner,
+			Slot:      bodyLocation,
+		}
+		if hadOld {
+			change.Before = &removed
+		}
+		if hasNew {
+			change.After = &added
+		}
+		a.equipmentLog.Changes = append(a.equipmentLog.Changes, change)
+
+		fmt.Printf("🛡️ EQUIPMENT (%s %s): %s -> %s\n", owner, bodyLocation, describeEquipped(change.Before), describeEquipped(change.After))
+	}
+}
+
+// ensureLoadout returns the ID of a loadout and registers it when it is new. Must be called with a.mu held.
+func (a *App) ensureLoadout(character string, items map[string]EquippedItem) string {
+	id := loadoutID(character, items)
+	for _, loadout := range a.equipmentLog.Loadouts {
+		if loadout.ID == id {
+			return id
+		}
+	}
+
+	copied := make(map[string]EquippedItem, len(items))
+	for slot, equipped := range items {
+		copied[slot] = equipped
+	}
+	a.equipmentLog.Loadouts = append(a.equipmentLog.Loadouts, Loadout{
+		ID:        id,
+		Character: character,
+		FirstSeen: time.Now(),
+		Items:     copied,
+	})
+	fmt.Printf("🛡️ New loadout %s for %s (%d items)\n", id, character, len(items))
+	return id
+}
+
+// getRunLoadout returns the loadout worn longest in the active run. Must be called with a.mu held.
+func (a *App) getRunLoadout() string {
+	runLoadout := ""
+	var longest time.Duration
+	for id, worn := range a.runLoadoutTime {
+		if worn > longest || (worn == longest && id < runLoadout) {
+			runLoadout = id
+			longest = worn
+		}
+	}
+	return runLoadout
+}
+
+// resetEquipmentTracking drops a swap in progress. Must be called with a.mu held.
+func (a *App) resetEquipmentTracking() {
+	a.pendingEquipment = nil
+	a.runLoadoutTime = make(map[string]time.Duration)
+}
+
+// ensureEquipmentLog fills in missing parts of a loaded log. Must be called with a.mu held.
+func (a *App) ensureEquipmentLog() {
+	if a.equipmentLog.Equipped == nil {
+		a.equipmentLog.Equipped = make(map[string]map[string]EquippedItem)
+	}
+	if a.equipmentLog.Changes == nil {
+		a.equipmentLog.Changes = make([]EquipmentChange, 0)
+	}
+	if a.equipmentLog.Loadouts == nil {
+		a.equipmentLog.Loadouts = make([]Loadout, 0)
+	}
+}
+
+// snapshot copies the log for encoding after a.mu is released. Must be called with a.mu held.
+// Slot maps are replaced rather than modified, so copying the outer map is enough.
+func (l EquipmentLog) snapshot() EquipmentLog {
+	equipped := make(map[string]map[string]EquippedItem, len(l.Equipped))
+	for character, slots := range l.Equipped {
+		equipped[character] = slots
+	}
+	l.Equipped = equipped
+	// Loadout names are edited in place
+	l.Loadouts = append([]Loadout(nil), l.Loadouts...)
+	return l
+}
+
+// ========== EQUIPMENT API ==========
+
+// GetEquipmentChanges returns the newest changes first; limit <= 0 returns all
+func (a *App) GetEquipmentChanges(limit int) []EquipmentChange {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	changes := make([]EquipmentChange, 0, len(a.equipmentLog.Changes))
+	for i := len(a.equipmentLog.Changes) - 1; i >= 0; i-- {
+		if limit > 0 && len(changes) >= limit {
+			break
+		}
+		changes = append(changes, a.equipmentLog.Changes[i])
+	}
+	return changes
+}
+
+// GetLoadouts groups runs by the loadout worn longest and compares their results
+func (a *App) GetLoadouts() []LoadoutStats {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	uniques := make(map[int]int)
+	sets := make(map[int]int)
+	for _, entry := range a.itemHistory {
+		switch entry.Quality {
+		case "Unique":
+			uniques[entry.RunIndex]++
+		case "Set":
+			sets[entry.RunIndex]++
+		}
+	}
+
+	stats := make([]LoadoutStats, 0, len(a.equipmentLog.Loadouts))
+	index := make(map[string]int, len(a.equipmentLog.Loadouts))
+	for _, loadout := range a.equipmentLog.Loadouts {
+		index[loadout.ID] = len(stats)
+		current := a.equipmentLog.Equipped[loadout.Character]
+		stats = append(stats, LoadoutStats{
+			ID:        loadout.ID,
+			Character: loadout.Character,
+			Name:      loadout.Name,
+			Items:     loadout.Items,
+			Current:   current != nil && loadoutID(loadout.Character, current) == loadout.ID,
+		})
+	}
+
+	minutes := make([]float64, len(stats))
+	xp := make([]int64, len(stats))
+	for _, record := range a.runRecords {
+		i, found := index[record.Loadout]
+		if !found {
+			continue
+		}
+		stats[i].Runs++
+		stats[i].Uniques += uniques[record.Index]
+		stats[i].Sets += sets[record.Index]
+		minutes[i] += (time.Duration(record.DurationMs) * time.Millisecond).Minutes()
+		xp[i] += record.XP
+	}
+	for i := range stats {
+		if stats[i].Runs == 0 {
+			continue
+		}
+		stats[i].AverageMinutes = minutes[i] / float64(stats[i].Runs)
+		stats[i].UniquesPerRun = float64(stats[i].Uniques) / float64(stats[i].Runs)
+		stats[i].SetsPerRun = float64(stats[i].Sets) / float64(stats[i].Runs)
+		if minutes[i] > 0 {
+			stats[i].XPPerMinute = float64(xp[i]) / minutes[i]
+		}
+	}
+
+	sort.SliceStable(stats, func(i, j int) bool { return stats[i].Runs > stats[j].Runs })
+	return stats
+}
+
+// NameLoadout gives a loadout a readable name; an empty name removes it
+func (a *App) NameLoadout(id string, name string) error {
+	a.mu.Lock()
+	found := false
+	for i := range a.equipmentLog.Loadouts {
+		if a.equipmentLog.Loadouts[i].ID == id {
+			a.equipmentLog.Loadouts[i].Name = strings.TrimSpace(name)
+			found = true
+			break
+		}
+	}
+	a.mu.Unlock()
+
+	if !found {
+		return fmt.Errorf("loadout not found: %s", id)
+	}
+	a.SaveCurrentProfile()
+	return nil
+}
+
+// ========== EQUIPMENT HELPERS ==========
+
+func sameEquipment(a, b map[string]EquippedItem) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for slot, equipped := range a {
+		if other, found := b[slot]; !found || other != equipped {
+			return false
+		}
+	}
+	return true
+}
+
+// loadoutID is a short hash of the character and every slot's item
+func loadoutID(character string, items map[string]EquippedItem) string {
+	slots := make([]string, 0, len(items))
+	for slot := range items {
+		slots = append(slots, slot)
+	}
+	sort.Strings(slots)
+
+	hash := fnv.New32a()
+	hash.Write([]byte(character))
+	for _, slot := range slots {
+		equipped := items[slot]
+		fmt.Fprintf(hash, "|%s=%s/%s/%v", slot, equipped.Name, equipped.Quality, equipped.Ethereal)
+	}
+	return fmt.Sprintf("%08x", hash.Sum32())
+}
+
+func describeEquipped(equipped *EquippedItem) string {
+	if equipped == nil {
+		return "empty"
+	}
+	return equipped.Name
+}
@@ -2,34 +2,164 @@
 // This file is automatically generated. DO NOT EDIT
 import {main} from '../models';
 
+export function BulkRenameItems(arg1:Array<main.ItemRef>,arg2:string):Promise<void>;
+
+export function BulkSetItemQuality(arg1:Array<main.ItemRef>,arg2:string):Promise<void>;
+
+export function CompareLoadouts(arg1:string,arg2:string):Promise<main.LoadoutComparison>;
+
 export function CreateProfile(arg1:string):Promise<void>;
 
+export function CreateTag(arg1:string):Promise<void>;
+
+export function DeleteItem(arg1:string,arg2:number):Promise<void>;
+
+export function DeleteItems(arg1:Array<main.ItemRef>):Promise<void>;
+
 export function DeleteProfile(arg1:string):Promise<void>;
 
-export function EditItemName(arg1:number,arg2:string):Promise<void>;
+export function DeleteShoppingRule(arg1:string):Promise<void>;
+
+export function DeleteTag(arg1:string):Promise<void>;
+
+export function EditItemName(arg1:string,arg2:number,arg3:string):Promise<void>;
 
 export function ExportItems():Promise<string>;
 
+export function FindDuplicateItems():Promise<Array<any>>;
+
 export function GetAllItems():Promise<Array<main.ItemEntry>>;
 
+export function GetCharacterInfo():Promise<main.CharacterInfo>;
+
+export function GetCharacterProfileConfig():Promise<main.CharacterProfileConfig>;
+
+export function GetCharacterSnapshots(arg1:string):Promise<Array<main.CharacterSnapshot>>;
+
+export function GetCharmStats():Promise<main.CharmStats>;
+
+export function GetCubeStats():Promise<main.CubeStats>;
+
+export function GetDeathHistory(arg1:number):Promise<Array<main.DeathRecord>>;
+
+export function GetDeathStats():Promise<main.DeathStats>;
+
+export function GetEquipmentChanges(arg1:number):Promise<Array<main.EquipmentChange>>;
+
 export function GetFilteredItems():Promise<Array<string>>;
 
+export function GetGambleHistory(arg1:number):Promise<Array<main.GambleRecord>>;
+
+export function GetGamblingStats():Promise<main.GamblingStats>;
+
+export function GetItemAuditLog():Promise<Array<main.AuditEntry>>;
+
 export function GetItemLists():Promise<main.ItemListResponse>;
 
+export function GetItemQualities():Promise<Array<string>>;
+
 export function GetItemsPage(arg1:number,arg2:number):Promise<main.ItemsResponse>;
 
+export function GetKeeperStats():Promise<main.KeeperStats>;
+
+export function GetLevelUps():Promise<Array<main.LevelUpRecord>>;
+
+export function GetLevelingCurve(arg1:string):Promise<Array<main.LevelingStep>>;
+
+export function GetLoadoutReport():Promise<Array<main.LoadoutGroup>>;
+
+export function GetLoadouts():Promise<Array<main.LoadoutStats>>;
+
+export function GetMagicFindStats():Promise<Array<main.MFBracket>>;
+
+export function GetMercDeaths(arg1:number):Promise<Array<main.MercDeath>>;
+
+export function GetMercHistory(arg1:string):Promise<main.MercHistory>;
+
+export function GetMercStatus():Promise<main.MercStatus>;
+
+export function GetPriceTable():Promise<main.PriceTable>;
+
+export function GetRunHistory():Promise<Array<main.RunRecord>>;
+
+export function GetRunewordHistory(arg1:number):Promise<Array<main.RunewordRecord>>;
+
+export function GetRunewordStats():Promise<main.RunewordStats>;
+
+export function GetShoppingMatches(arg1:number):Promise<Array<main.ShoppingMatch>>;
+
+export function GetShoppingRules():Promise<Array<main.ShoppingRule>>;
+
+export function GetStashChanges(arg1:string,arg2:number):Promise<Array<main.StashChange>>;
+
+export function GetStashCharacters():Promise<Array<string>>;
+
 export function GetStats():Promise<main.GameStats>;
 
+export function GetTags():Promise<Array<string>>;
+
+export function GetTransmuteHistory(arg1:number):Promise<Array<main.CubeTransmute>>;
+
+export function GetUberStats():Promise<main.UberStats>;
+
+export function GetXPEfficiency():Promise<main.XPEfficiency>;
+
+export function GetXPHistory(arg1:number):Promise<Array<main.XPRunEntry>>;
+
+export function GetXPModel():Promise<Array<main.XPLevel>>;
+
+export function GetXPRates():Promise<main.XPRates>;
+
 export function LoadProfile(arg1:string):Promise<void>;
 
+export function MergeDuplicateItems(arg1:Array<main.ItemRef>):Promise<void>;
+
+export function NameLoadout(arg1:string,arg2:string):Promise<void>;
+
+export function QueryItems(arg1:main.ItemQuery):Promise<main.ItemsResponse>;
+
+export function RedoItemChange():Promise<main.AuditEntry>;
+
+export function ReloadPriceTable():Promise<void>;
+
+export function RenameTag(arg1:string,arg2:string):Promise<void>;
+
 export function ResetKills():Promise<void>;
 
 export function SaveCurrentProfile():Promise<void>;
 
+export function SaveShoppingRule(arg1:main.ShoppingRule):Promise<main.ShoppingRule>;
+
+export function SearchStash(arg1:string,arg2:string,arg3:string):Promise<main.StashView>;
+
+export function SetAutoProfileSwitching(arg1:boolean):Promise<void>;
+
+export function SetCharacterProfile(arg1:string,arg2:string):Promise<void>;
+
+export function SetItemFavorite(arg1:string,arg2:number,arg3:boolean):Promise<void>;
+
+export function SetItemNote(arg1:string,arg2:number,arg3:string):Promise<void>;
+
+export function SetItemPrice(arg1:string,arg2:string,arg3:number):Promise<void>;
+
+export function SetItemQualityFilter(arg1:string):Promise<string>;
+
+export function SetItemTagFilter(arg1:string):Promise<string>;
+
+export function SetItemTags(arg1:string,arg2:number,arg3:Array<string>):Promise<void>;
+
 export function SetItemsPerPage(arg1:number):Promise<number>;
 
+export function SetRunLoadout(arg1:number,arg2:string):Promise<void>;
+
 export function SetShowAllItems(arg1:boolean):Promise<boolean>;
 
+export function SetShowFavoritesOnly(arg1:boolean):Promise<boolean>;
+
+export function SetXPRateWindows(arg1:number,arg2:number):Promise<main.XPRateSettings>;
+
 export function SwitchProfile(arg1:string):Promise<void>;
 
 export function ToggleFilters():Promise<boolean>;
+
+export function UndoItemChange():Promise<main.AuditEntry>;
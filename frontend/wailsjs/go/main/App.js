@@ -2,46 +2,250 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
+export function BulkRenameItems(arg1, arg2) {
+  return window['go']['main']['App']['BulkRenameItems'](arg1, arg2);
+}
+
+export function BulkSetItemQuality(arg1, arg2) {
+  return window['go']['main']['App']['BulkSetItemQuality'](arg1, arg2);
+}
+
+export function CompareLoadouts(arg1, arg2) {
+  return window['go']['main']['App']['CompareLoadouts'](arg1, arg2);
+}
+
 export function CreateProfile(arg1) {
   return window['go']['main']['App']['CreateProfile'](arg1);
 }
 
+export function CreateTag(arg1) {
+  return window['go']['main']['App']['CreateTag'](arg1);
+}
+
+export function DeleteItem(arg1, arg2) {
+  return window['go']['main']['App']['DeleteItem'](arg1, arg2);
+}
+
+export function DeleteItems(arg1) {
+  return window['go']['main']['App']['DeleteItems'](arg1);
+}
+
 export function DeleteProfile(arg1) {
   return window['go']['main']['App']['DeleteProfile'](arg1);
 }
 
-export function EditItemName(arg1, arg2) {
-  return window['go']['main']['App']['EditItemName'](arg1, arg2);
+export function DeleteShoppingRule(arg1) {
+  return window['go']['main']['App']['DeleteShoppingRule'](arg1);
+}
+
+export function DeleteTag(arg1) {
+  return window['go']['main']['App']['DeleteTag'](arg1);
+}
+
+export function EditItemName(arg1, arg2, arg3) {
+  return window['go']['main']['App']['EditItemName'](arg1, arg2, arg3);
 }
 
 export function ExportItems() {
   return window['go']['main']['App']['ExportItems']();
 }
 
+export function FindDuplicateItems() {
+  return window['go']['main']['App']['FindDuplicateItems']();
+}
+
 export function GetAllItems() {
   return window['go']['main']['App']['GetAllItems']();
 }
 
+export function GetCharacterInfo() {
+  return window['go']['main']['App']['GetCharacterInfo']();
+}
+
+export function GetCharacterProfileConfig() {
+  return window['go']['main']['App']['GetCharacterProfileConfig']();
+}
+
+export function GetCharacterSnapshots(arg1) {
+  return window['go']['main']['App']['GetCharacterSnapshots'](arg1);
+}
+
+export function GetCharmStats() {
+  return window['go']['main']['App']['GetCharmStats']();
+}
+
+export function GetCubeStats() {
+  return window['go']['main']['App']['GetCubeStats']();
+}
+
+export function GetDeathHistory(arg1) {
+  return window['go']['main']['App']['GetDeathHistory'](arg1);
+}
+
+export function GetDeathStats() {
+  return window['go']['main']['App']['GetDeathStats']();
+}
+
+export function GetEquipmentChanges(arg1) {
+  return window['go']['main']['App']['GetEquipmentChanges'](arg1);
+}
+
 export function GetFilteredItems() {
   return window['go']['main']['App']['GetFilteredItems']();
 }
 
+export function GetGambleHistory(arg1) {
+  return window['go']['main']['App']['GetGambleHistory'](arg1);
+}
+
+export function GetGamblingStats() {
+  return window['go']['main']['App']['GetGamblingStats']();
+}
+
+export function GetItemAuditLog() {
+  return window['go']['main']['App']['GetItemAuditLog']();
+}
+
 export function GetItemLists() {
   return window['go']['main']['App']['GetItemLists']();
 }
 
+export function GetItemQualities() {
+  return window['go']['main']['App']['GetItemQualities']();
+}
+
 export function GetItemsPage(arg1, arg2) {
   return window['go']['main']['App']['GetItemsPage'](arg1, arg2);
 }
 
+export function GetKeeperStats() {
+  return window['go']['main']['App']['GetKeeperStats']();
+}
+
+export function GetLevelUps() {
+  return window['go']['main']['App']['GetLevelUps']();
+}
+
+export function GetLevelingCurve(arg1) {
+  return window['go']['main']['App']['GetLevelingCurve'](arg1);
+}
+
+export function GetLoadoutReport() {
+  return window['go']['main']['App']['GetLoadoutReport']();
+}
+
+export function GetLoadouts() {
+  return window['go']['main']['App']['GetLoadouts']();
+}
+
+export function GetMagicFindStats() {
+  return window['go']['main']['App']['GetMagicFindStats']();
+}
+
+export function GetMercDeaths(arg1) {
+  return window['go']['main']['App']['GetMercDeaths'](arg1);
+}
+
+export function GetMercHistory(arg1) {
+  return window['go']['main']['App']['GetMercHistory'](arg1);
+}
+
+export function GetMercStatus() {
+  return window['go']['main']['App']['GetMercStatus']();
+}
+
+export function GetPriceTable() {
+  return window['go']['main']['App']['GetPriceTable']();
+}
+
+export function GetRunHistory() {
+  return window['go']['main']['App']['GetRunHistory']();
+}
+
+export function GetRunewordHistory(arg1) {
+  return window['go']['main']['App']['GetRunewordHistory'](arg1);
+}
+
+export function GetRunewordStats() {
+  return window['go']['main']['App']['GetRunewordStats']();
+}
+
+export function GetShoppingMatches(arg1) {
+  return window['go']['main']['App']['GetShoppingMatches'](arg1);
+}
+
+export function GetShoppingRules() {
+  return window['go']['main']['App']['GetShoppingRules']();
+}
+
+export function GetStashChanges(arg1, arg2) {
+  return window['go']['main']['App']['GetStashChanges'](arg1, arg2);
+}
+
+export function GetStashCharacters() {
+  return window['go']['main']['App']['GetStashCharacters']();
+}
+
 export function GetStats() {
   return window['go']['main']['App']['GetStats']();
 }
 
+export function GetTags() {
+  return window['go']['main']['App']['GetTags']();
+}
+
+export function GetTransmuteHistory(arg1) {
+  return window['go']['main']['App']['GetTransmuteHistory'](arg1);
+}
+
+export function GetUberStats() {
+  return window['go']['main']['App']['GetUberStats']();
+}
+
+export function GetXPEfficiency() {
+  return window['go']['main']['App']['GetXPEfficiency']();
+}
+
+export function GetXPHistory(arg1) {
+  return window['go']['main']['App']['GetXPHistory'](arg1);
+}
+
+export function GetXPModel() {
+  return window['go']['main']['App']['GetXPModel']();
+}
+
+export function GetXPRates() {
+  return window['go']['main']['App']['GetXPRates']();
+}
+
 export function LoadProfile(arg1) {
   return window['go']['main']['App']['LoadProfile'](arg1);
 }
 
+export function MergeDuplicateItems(arg1) {
+  return window['go']['main']['App']['MergeDuplicateItems'](arg1);
+}
+
+export function NameLoadout(arg1, arg2) {
+  return window['go']['main']['App']['NameLoadout'](arg1, arg2);
+}
+
+export function QueryItems(arg1) {
+  return window['go']['main']['App']['QueryItems'](arg1);
+}
+
+export function RedoItemChange() {
+  return window['go']['main']['App']['RedoItemChange']();
+}
+
+export function ReloadPriceTable() {
+  return window['go']['main']['App']['ReloadPriceTable']();
+}
+
+export function RenameTag(arg1, arg2) {
+  return window['go']['main']['App']['RenameTag'](arg1, arg2);
+}
+
 export function ResetKills() {
   return window['go']['main']['App']['ResetKills']();
 }
@@ -50,14 +254,66 @@ export function SaveCurrentProfile() {
   return window['go']['main']['App']['SaveCurrentProfile']();
 }
 
+export function SaveShoppingRule(arg1) {
+  return window['go']['main']['App']['SaveShoppingRule'](arg1);
+}
+
+export function SearchStash(arg1, arg2, arg3) {
+  return window['go']['main']['App']['SearchStash'](arg1, arg2, arg3);
+}
+
+export function SetAutoProfileSwitching(arg1) {
+  return window['go']['main']['App']['SetAutoProfileSwitching'](arg1);
+}
+
+export function SetCharacterProfile(arg1, arg2) {
+  return window['go']['main']['App']['SetCharacterProfile'](arg1, arg2);
+}
+
+export function SetItemFavorite(arg1, arg2, arg3) {
+  return window['go']['main']['App']['SetItemFavorite'](arg1, arg2, arg3);
+}
+
+export function SetItemNote(arg1, arg2, arg3) {
+  return window['go']['main']['App']['SetItemNote'](arg1, arg2, arg3);
+}
+
+export function SetItemPrice(arg1, arg2, arg3) {
+  return window['go']['main']['App']['SetItemPrice'](arg1, arg2, arg3);
+}
+
+export function SetItemQualityFilter(arg1) {
+  return window['go']['main']['App']['SetItemQualityFilter'](arg1);
+}
+
+export function SetItemTagFilter(arg1) {
+  return window['go']['main']['App']['SetItemTagFilter'](arg1);
+}
+
+export function SetItemTags(arg1, arg2, arg3) {
+  return window['go']['main']['App']['SetItemTags'](arg1, arg2, arg3);
+}
+
 export function SetItemsPerPage(arg1) {
   return window['go']['main']['App']['SetItemsPerPage'](arg1);
 }
 
+export function SetRunLoadout(arg1, arg2) {
+  return window['go']['main']['App']['SetRunLoadout'](arg1, arg2);
+}
+
 export function SetShowAllItems(arg1) {
   return window['go']['main']['App']['SetShowAllItems'](arg1);
 }
 
+export function SetShowFavoritesOnly(arg1) {
+  return window['go']['main']['App']['SetShowFavoritesOnly'](arg1);
+}
+
+export function SetXPRateWindows(arg1, arg2) {
+  return window['go']['main']['App']['SetXPRateWindows'](arg1, arg2);
+}
+
 export function SwitchProfile(arg1) {
   return window['go']['main']['App']['SwitchProfile'](arg1);
 }
@@ -65,3 +321,7 @@ export function SwitchProfile(arg1) {
 export function ToggleFilters() {
   return window['go']['main']['App']['ToggleFilters']();
 }
+
+export function UndoItemChange() {
+  return window['go']['main']['App']['UndoItemChange']();
+}
@@ -1,25 +1,38 @@
 export namespace main {
 	
-	export class ItemsResponse {
-	    items: ItemEntry[];
-	    total_items: number;
-	    current_page: number;
-	    items_per_page: number;
-	    total_pages: number;
-	    show_all: boolean;
+	export class CharmInfo {
+	    kind: string;
+	    class: string;
+	    rolls: string[];
+	    score?: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new ItemsResponse(source);
+	        return new CharmInfo(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.items = this.convertValues(source["items"], ItemEntry);
-	        this.total_items = source["total_items"];
-	        this.current_page = source["current_page"];
-	        this.items_per_page = source["items_per_page"];
-	        this.total_pages = source["total_pages"];
-	        this.show_all = source["show_all"];
+	        this.kind = source["kind"];
+	        this.class = source["class"];
+	        this.rolls = source["rolls"];
+	        this.score = source["score"];
+	    }
+	}
+	export class ItemEvent {
+	    // Go type: time
+	    time: any;
+	    status: string;
+	    area?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ItemEvent(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.time = this.convertValues(source["time"], null);
+	        this.status = source["status"];
+	        this.area = source["area"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -40,39 +53,9 @@ export namespace main {
 		    return a;
 		}
 	}
-	export class XPTracking {
-	    current_xp: number;
-	    current_level: number;
-	    xp_to_next_level: number;
-	    xp_this_run: number;
-	    xp_per_hour: number;
-	    runs_to_next_level: number;
-	    average_xp_per_run: number;
-	    session_xp_gained: number;
-	    run_start_xp: number;
-	    estimated_runs_to_next: number;
-	    runs_calculation_method: string;
-	
-	    static createFrom(source: any = {}) {
-	        return new XPTracking(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.current_xp = source["current_xp"];
-	        this.current_level = source["current_level"];
-	        this.xp_to_next_level = source["xp_to_next_level"];
-	        this.xp_this_run = source["xp_this_run"];
-	        this.xp_per_hour = source["xp_per_hour"];
-	        this.runs_to_next_level = source["runs_to_next_level"];
-	        this.average_xp_per_run = source["average_xp_per_run"];
-	        this.session_xp_gained = source["session_xp_gained"];
-	        this.run_start_xp = source["run_start_xp"];
-	        this.estimated_runs_to_next = source["estimated_runs_to_next"];
-	        this.runs_calculation_method = source["runs_calculation_method"];
-	    }
-	}
 	export class ItemEntry {
+	    id: string;
+	    version: number;
 	    name: string;
 	    original_name: string;
 	    quality: string;
@@ -83,7 +66,16 @@ export namespace main {
 	    is_ethereal?: boolean;
 	    is_identified?: boolean;
 	    item_level?: number;
-	    array_index: number;
+	    area?: string;
+	    run_type?: string;
+	    tags?: string[];
+	    note?: string;
+	    favorite?: boolean;
+	    value?: number;
+	    status?: string;
+	    lifecycle?: ItemEvent[];
+	    track_key?: string;
+	    charm?: CharmInfo;
 	
 	    static createFrom(source: any = {}) {
 	        return new ItemEntry(source);
@@ -91,6 +83,8 @@ export namespace main {
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.version = source["version"];
 	        this.name = source["name"];
 	        this.original_name = source["original_name"];
 	        this.quality = source["quality"];
@@ -100,7 +94,16 @@ export namespace main {
 	        this.is_ethereal = source["is_ethereal"];
 	        this.is_identified = source["is_identified"];
 	        this.item_level = source["item_level"];
-	        this.array_index = source["array_index"];
+	        this.area = source["area"];
+	        this.run_type = source["run_type"];
+	        this.tags = source["tags"];
+	        this.note = source["note"];
+	        this.favorite = source["favorite"];
+	        this.value = source["value"];
+	        this.status = source["status"];
+	        this.lifecycle = this.convertValues(source["lifecycle"], ItemEvent);
+	        this.track_key = source["track_key"];
+	        this.charm = this.convertValues(source["charm"], CharmInfo);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -121,61 +124,229 @@ export namespace main {
 		    return a;
 		}
 	}
-	export class GameStats {
-	    normal: number;
-	    champion: number;
-	    unique: number;
-	    superUnique: number;
-	    minion: number;
-	    total: number;
-	    currentRun: string;
-	    fastestRun: string;
-	    slowestRun: string;
-	    averageRun: string;
-	    totalRuns: number;
-	    runActive: boolean;
-	    totalItems: number;
-	    recentItems: ItemEntry[];
-	    currentProfile: string;
-	    profiles: string[];
-	    filtersEnabled: boolean;
-	    xpTracking: XPTracking;
-	    playerLevel: number;
-	    playerClass: string;
-	    currentArea: string;
+	export class ItemChange {
+	    position: number;
+	    before?: ItemEntry;
+	    after?: ItemEntry;
+	
+	    static createFrom(source: any = {}) {
+	        return new ItemChange(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.position = source["position"];
+	        this.before = this.convertValues(source["before"], ItemEntry);
+	        this.after = this.convertValues(source["after"], ItemEntry);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class AuditEntry {
+	    id: number;
 	    // Go type: time
-	    sessionStartTime: any;
-	    itemsData: ItemsResponse;
+	    time: any;
+	    action: string;
+	    description: string;
+	    changes: ItemChange[];
+	    undone: boolean;
+	    discarded?: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new GameStats(source);
+	        return new AuditEntry(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.normal = source["normal"];
-	        this.champion = source["champion"];
-	        this.unique = source["unique"];
-	        this.superUnique = source["superUnique"];
-	        this.minion = source["minion"];
-	        this.total = source["total"];
-	        this.currentRun = source["currentRun"];
-	        this.fastestRun = source["fastestRun"];
-	        this.slowestRun = source["slowestRun"];
-	        this.averageRun = source["averageRun"];
-	        this.totalRuns = source["totalRuns"];
-	        this.runActive = source["runActive"];
-	        this.totalItems = source["totalItems"];
-	        this.recentItems = this.convertValues(source["recentItems"], ItemEntry);
-	        this.currentProfile = source["currentProfile"];
+	        this.id = source["id"];
+	        this.time = this.convertValues(source["time"], null);
+	        this.action = source["action"];
+	        this.description = source["description"];
+	        this.changes = this.convertValues(source["changes"], ItemChange);
+	        this.undone = source["undone"];
+	        this.discarded = source["discarded"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CharacterInfo {
+	    name: string;
+	    class: string;
+	    level: number;
+	    hardcore: boolean;
+	    ladder: boolean;
+	    expansion: boolean;
+	    flags_known: boolean;
+	    profile: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CharacterInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.class = source["class"];
+	        this.level = source["level"];
+	        this.hardcore = source["hardcore"];
+	        this.ladder = source["ladder"];
+	        this.expansion = source["expansion"];
+	        this.flags_known = source["flags_known"];
+	        this.profile = source["profile"];
+	    }
+	}
+	export class CharacterProfileConfig {
+	    auto_switch: boolean;
+	    save_dir?: string;
+	    profiles: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new CharacterProfileConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.auto_switch = source["auto_switch"];
+	        this.save_dir = source["save_dir"];
 	        this.profiles = source["profiles"];
-	        this.filtersEnabled = source["filtersEnabled"];
-	        this.xpTracking = this.convertValues(source["xpTracking"], XPTracking);
-	        this.playerLevel = source["playerLevel"];
-	        this.playerClass = source["playerClass"];
-	        this.currentArea = source["currentArea"];
-	        this.sessionStartTime = this.convertValues(source["sessionStartTime"], null);
-	        this.itemsData = this.convertValues(source["itemsData"], ItemsResponse);
+	    }
+	}
+	export class Resistances {
+	    fire: number;
+	    cold: number;
+	    lightning: number;
+	    poison: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new Resistances(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.fire = source["fire"];
+	        this.cold = source["cold"];
+	        this.lightning = source["lightning"];
+	        this.poison = source["poison"];
+	    }
+	}
+	export class CharacterSnapshot {
+	    // Go type: time
+	    time: any;
+	    run_index: number;
+	    character: string;
+	    class: string;
+	    level: number;
+	    strength: number;
+	    dexterity: number;
+	    vitality: number;
+	    energy: number;
+	    max_life: number;
+	    max_mana: number;
+	    magic_find: number;
+	    gold_find: number;
+	    fcr: number;
+	    fhr: number;
+	    frw: number;
+	    ias: number;
+	    resistances: Record<string, Resistances>;
+	
+	    static createFrom(source: any = {}) {
+	        return new CharacterSnapshot(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.time = this.convertValues(source["time"], null);
+	        this.run_index = source["run_index"];
+	        this.character = source["character"];
+	        this.class = source["class"];
+	        this.level = source["level"];
+	        this.strength = source["strength"];
+	        this.dexterity = source["dexterity"];
+	        this.vitality = source["vitality"];
+	        this.energy = source["energy"];
+	        this.max_life = source["max_life"];
+	        this.max_mana = source["max_mana"];
+	        this.magic_find = source["magic_find"];
+	        this.gold_find = source["gold_find"];
+	        this.fcr = source["fcr"];
+	        this.fhr = source["fhr"];
+	        this.frw = source["frw"];
+	        this.ias = source["ias"];
+	        this.resistances = this.convertValues(source["resistances"], Resistances, true);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CharmDrop {
+	    item_id: string;
+	    name: string;
+	    quality: string;
+	    // Go type: time
+	    time: any;
+	    run_type: string;
+	    charm: CharmInfo;
+	
+	    static createFrom(source: any = {}) {
+	        return new CharmDrop(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.item_id = source["item_id"];
+	        this.name = source["name"];
+	        this.quality = source["quality"];
+	        this.time = this.convertValues(source["time"], null);
+	        this.run_type = source["run_type"];
+	        this.charm = this.convertValues(source["charm"], CharmInfo);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -197,23 +368,2031 @@ export namespace main {
 		}
 	}
 	
-	export class ItemListResponse {
-	    unique_items: string[];
-	    set_items: string[];
-	    all_special_items: string[];
-	    total_count: number;
+	export class RunTypeDrops {
+	    run_type: string;
+	    runs: number;
+	    drops: Record<string, number>;
+	    per_hundred_runs: Record<string, number>;
 	
 	    static createFrom(source: any = {}) {
-	        return new ItemListResponse(source);
+	        return new RunTypeDrops(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.unique_items = source["unique_items"];
-	        this.set_items = source["set_items"];
-	        this.all_special_items = source["all_special_items"];
-	        this.total_count = source["total_count"];
+	        this.run_type = source["run_type"];
+	        this.runs = source["runs"];
+	        this.drops = source["drops"];
+	        this.per_hundred_runs = source["per_hundred_runs"];
+	    }
+	}
+	export class CharmStats {
+	    charms: number;
+	    jewels: number;
+	    by_class: Record<string, number>;
+	    uniques: CharmDrop[];
+	    top_jewels: CharmDrop[];
+	    run_types: RunTypeDrops[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CharmStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.charms = source["charms"];
+	        this.jewels = source["jewels"];
+	        this.by_class = source["by_class"];
+	        this.uniques = this.convertValues(source["uniques"], CharmDrop);
+	        this.top_jewels = this.convertValues(source["top_jewels"], CharmDrop);
+	        this.run_types = this.convertValues(source["run_types"], RunTypeDrops);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CubeItem {
+	    name: string;
+	    quality: string;
+	    ethereal?: boolean;
+	    sockets?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CubeItem(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.quality = source["quality"];
+	        this.ethereal = source["ethereal"];
+	        this.sockets = source["sockets"];
+	    }
+	}
+	export class CubeStats {
+	    transmutes: number;
+	    by_recipe: Record<string, number>;
+	    runes_spent: Record<string, number>;
+	    runes_created: Record<string, number>;
+	
+	    static createFrom(source: any = {}) {
+	        return new CubeStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.transmutes = source["transmutes"];
+	        this.by_recipe = source["by_recipe"];
+	        this.runes_spent = source["runes_spent"];
+	        this.runes_created = source["runes_created"];
+	    }
+	}
+	export class CubeTransmute {
+	    // Go type: time
+	    time: any;
+	    run_index: number;
+	    area?: string;
+	    recipe: string;
+	    inputs: CubeItem[];
+	    outputs: CubeItem[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CubeTransmute(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.time = this.convertValues(source["time"], null);
+	        this.run_index = source["run_index"];
+	        this.area = source["area"];
+	        this.recipe = source["recipe"];
+	        this.inputs = this.convertValues(source["inputs"], CubeItem);
+	        this.outputs = this.convertValues(source["outputs"], CubeItem);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class DeathRecord {
+	    // Go type: time
+	    time: any;
+	    run_index: number;
+	    area?: string;
+	    character?: string;
+	    level: number;
+	    xp_lost: number;
+	    gold_lost: number;
+	    detection: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DeathRecord(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.time = this.convertValues(source["time"], null);
+	        this.run_index = source["run_index"];
+	        this.area = source["area"];
+	        this.character = source["character"];
+	        this.level = source["level"];
+	        this.xp_lost = source["xp_lost"];
+	        this.gold_lost = source["gold_lost"];
+	        this.detection = source["detection"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class DeathStats {
+	    total_deaths: number;
+	    session_deaths: number;
+	    total_xp_lost: number;
+	    session_xp_lost: number;
+	    total_gold_lost: number;
+	    session_gold_lost: number;
+	    gross_xp_per_hour: number;
+	    net_xp_per_hour: number;
+	    by_area: Record<string, number>;
+	
+	    static createFrom(source: any = {}) {
+	        return new DeathStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.total_deaths = source["total_deaths"];
+	        this.session_deaths = source["session_deaths"];
+	        this.total_xp_lost = source["total_xp_lost"];
+	        this.session_xp_lost = source["session_xp_lost"];
+	        this.total_gold_lost = source["total_gold_lost"];
+	        this.session_gold_lost = source["session_gold_lost"];
+	        this.gross_xp_per_hour = source["gross_xp_per_hour"];
+	        this.net_xp_per_hour = source["net_xp_per_hour"];
+	        this.by_area = source["by_area"];
+	    }
+	}
+	export class EquippedItem {
+	    name: string;
+	    quality: string;
+	    ethereal?: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new EquippedItem(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.quality = source["quality"];
+	        this.ethereal = source["ethereal"];
+	    }
+	}
+	export class EquipmentChange {
+	    // Go type: time
+	    time: any;
+	    run_index: number;
+	    area?: string;
+	    character: string;
+	    owner: string;
+	    slot: string;
+	    before?: EquippedItem;
+	    after?: EquippedItem;
+	
+	    static createFrom(source: any = {}) {
+	        return new EquipmentChange(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.time = this.convertValues(source["time"], null);
+	        this.run_index = source["run_index"];
+	        this.area = source["area"];
+	        this.character = source["character"];
+	        this.owner = source["owner"];
+	        this.slot = source["slot"];
+	        this.before = this.convertValues(source["before"], EquippedItem);
+	        this.after = this.convertValues(source["after"], EquippedItem);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class GambleBaseStats {
+	    base: string;
+	    gambles: number;
+	    gold_spent: number;
+	    uniques: number;
+	    sets: number;
+	    rares: number;
+	    total_value: number;
+	    hits_per_million: number;
+	    value_per_million: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new GambleBaseStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.base = source["base"];
+	        this.gambles = source["gambles"];
+	        this.gold_spent = source["gold_spent"];
+	        this.uniques = source["uniques"];
+	        this.sets = source["sets"];
+	        this.rares = source["rares"];
+	        this.total_value = source["total_value"];
+	        this.hits_per_million = source["hits_per_million"];
+	        this.value_per_million = source["value_per_million"];
+	    }
+	}
+	export class GambleRecord {
+	    // Go type: time
+	    time: any;
+	    run_index: number;
+	    vendor: string;
+	    base: string;
+	    quality: string;
+	    name?: string;
+	    gold_spent: number;
+	    value?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new GambleRecord(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.time = this.convertValues(source["time"], null);
+	        this.run_index = source["run_index"];
+	        this.vendor = source["vendor"];
+	        this.base = source["base"];
+	        this.quality = source["quality"];
+	        this.name = source["name"];
+	        this.gold_spent = source["gold_spent"];
+	        this.value = source["value"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class GamblingStats {
+	    gambles: number;
+	    gold_spent: number;
+	    uniques: number;
+	    sets: number;
+	    rares: number;
+	    magic: number;
+	    total_value: number;
+	    currency: string;
+	    by_base: GambleBaseStats[];
+	
+	    static createFrom(source: any = {}) {
+	        return new GamblingStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.gambles = source["gambles"];
+	        this.gold_spent = source["gold_spent"];
+	        this.uniques = source["uniques"];
+	        this.sets = source["sets"];
+	        this.rares = source["rares"];
+	        this.magic = source["magic"];
+	        this.total_value = source["total_value"];
+	        this.currency = source["currency"];
+	        this.by_base = this.convertValues(source["by_base"], GambleBaseStats);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class MercStatus {
+	    character: string;
+	    known: boolean;
+	    type: string;
+	    level: number;
+	    alive: boolean;
+	    run_deaths: number;
+	    session_deaths: number;
+	    total_deaths: number;
+	    session_resurrect_gold: number;
+	    total_resurrect_gold: number;
+	    deaths_per_run: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new MercStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.character = source["character"];
+	        this.known = source["known"];
+	        this.type = source["type"];
+	        this.level = source["level"];
+	        this.alive = source["alive"];
+	        this.run_deaths = source["run_deaths"];
+	        this.session_deaths = source["session_deaths"];
+	        this.total_deaths = source["total_deaths"];
+	        this.session_resurrect_gold = source["session_resurrect_gold"];
+	        this.total_resurrect_gold = source["total_resurrect_gold"];
+	        this.deaths_per_run = source["deaths_per_run"];
+	    }
+	}
+	export class GoldBreakdown {
+	    pickup: number;
+	    vendor: number;
+	    repair: number;
+	    shopping: number;
+	    gambling: number;
+	    merc: number;
+	    death: number;
+	    other: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new GoldBreakdown(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.pickup = source["pickup"];
+	        this.vendor = source["vendor"];
+	        this.repair = source["repair"];
+	        this.shopping = source["shopping"];
+	        this.gambling = source["gambling"];
+	        this.merc = source["merc"];
+	        this.death = source["death"];
+	        this.other = source["other"];
+	    }
+	}
+	export class GoldStats {
+	    currentGold: number;
+	    sessionIncome: number;
+	    sessionExpense: number;
+	    sessionNet: number;
+	    goldPerHour: number;
+	    session: GoldBreakdown;
+	    currentRun: GoldBreakdown;
+	
+	    static createFrom(source: any = {}) {
+	        return new GoldStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.currentGold = source["currentGold"];
+	        this.sessionIncome = source["sessionIncome"];
+	        this.sessionExpense = source["sessionExpense"];
+	        this.sessionNet = source["sessionNet"];
+	        this.goldPerHour = source["goldPerHour"];
+	        this.session = this.convertValues(source["session"], GoldBreakdown);
+	        this.currentRun = this.convertValues(source["currentRun"], GoldBreakdown);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class RunTypeValue {
+	    runType: string;
+	    runs: number;
+	    totalValue: number;
+	    valuePerRun: number;
+	    valuePerHour: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RunTypeValue(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.runType = source["runType"];
+	        this.runs = source["runs"];
+	        this.totalValue = source["totalValue"];
+	        this.valuePerRun = source["valuePerRun"];
+	        this.valuePerHour = source["valuePerHour"];
+	    }
+	}
+	export class ValueStats {
+	    currency: string;
+	    totalValue: number;
+	    currentRunValue: number;
+	    valuePerRun: number;
+	    valuePerHour: number;
+	    runTypes: RunTypeValue[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ValueStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.currency = source["currency"];
+	        this.totalValue = source["totalValue"];
+	        this.currentRunValue = source["currentRunValue"];
+	        this.valuePerRun = source["valuePerRun"];
+	        this.valuePerHour = source["valuePerHour"];
+	        this.runTypes = this.convertValues(source["runTypes"], RunTypeValue);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ItemsResponse {
+	    items: ItemEntry[];
+	    total_items: number;
+	    current_page: number;
+	    items_per_page: number;
+	    total_pages: number;
+	    show_all: boolean;
+	    quality_filter: string;
+	    tag_filter: string;
+	    favorites_only: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ItemsResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.items = this.convertValues(source["items"], ItemEntry);
+	        this.total_items = source["total_items"];
+	        this.current_page = source["current_page"];
+	        this.items_per_page = source["items_per_page"];
+	        this.total_pages = source["total_pages"];
+	        this.show_all = source["show_all"];
+	        this.quality_filter = source["quality_filter"];
+	        this.tag_filter = source["tag_filter"];
+	        this.favorites_only = source["favorites_only"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class XPForecast {
+	    xp_needed: number;
+	    hours: number;
+	    hours_low: number;
+	    hours_high: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new XPForecast(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.xp_needed = source["xp_needed"];
+	        this.hours = source["hours"];
+	        this.hours_low = source["hours_low"];
+	        this.hours_high = source["hours_high"];
+	    }
+	}
+	export class XPRates {
+	    window_minutes: number;
+	    window_runs: number;
+	    recent_per_hour: number;
+	    runs_per_hour: number;
+	    session_per_hour: number;
+	    runs_used: number;
+	    basis: string;
+	    confidence: string;
+	    next_level: XPForecast;
+	    level_99: XPForecast;
+	
+	    static createFrom(source: any = {}) {
+	        return new XPRates(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.window_minutes = source["window_minutes"];
+	        this.window_runs = source["window_runs"];
+	        this.recent_per_hour = source["recent_per_hour"];
+	        this.runs_per_hour = source["runs_per_hour"];
+	        this.session_per_hour = source["session_per_hour"];
+	        this.runs_used = source["runs_used"];
+	        this.basis = source["basis"];
+	        this.confidence = source["confidence"];
+	        this.next_level = this.convertValues(source["next_level"], XPForecast);
+	        this.level_99 = this.convertValues(source["level_99"], XPForecast);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class XPTracking {
+	    current_xp: number;
+	    current_level: number;
+	    xp_to_next_level: number;
+	    xp_this_run: number;
+	    xp_per_hour: number;
+	    runs_to_next_level: number;
+	    average_xp_per_run: number;
+	    session_xp_gained: number;
+	    run_start_xp: number;
+	    estimated_runs_to_next: number;
+	    runs_calculation_method: string;
+	    level_progress: number;
+	    xp_to_level_99: number;
+	    session_xp_lost: number;
+	    net_xp_per_hour: number;
+	    session_deaths: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new XPTracking(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.current_xp = source["current_xp"];
+	        this.current_level = source["current_level"];
+	        this.xp_to_next_level = source["xp_to_next_level"];
+	        this.xp_this_run = source["xp_this_run"];
+	        this.xp_per_hour = source["xp_per_hour"];
+	        this.runs_to_next_level = source["runs_to_next_level"];
+	        this.average_xp_per_run = source["average_xp_per_run"];
+	        this.session_xp_gained = source["session_xp_gained"];
+	        this.run_start_xp = source["run_start_xp"];
+	        this.estimated_runs_to_next = source["estimated_runs_to_next"];
+	        this.runs_calculation_method = source["runs_calculation_method"];
+	        this.level_progress = source["level_progress"];
+	        this.xp_to_level_99 = source["xp_to_level_99"];
+	        this.session_xp_lost = source["session_xp_lost"];
+	        this.net_xp_per_hour = source["net_xp_per_hour"];
+	        this.session_deaths = source["session_deaths"];
+	    }
+	}
+	export class GameStats {
+	    normal: number;
+	    champion: number;
+	    unique: number;
+	    superUnique: number;
+	    minion: number;
+	    total: number;
+	    currentRun: string;
+	    fastestRun: string;
+	    slowestRun: string;
+	    averageRun: string;
+	    totalRuns: number;
+	    runActive: boolean;
+	    totalItems: number;
+	    recentItems: ItemEntry[];
+	    currentProfile: string;
+	    profiles: string[];
+	    filtersEnabled: boolean;
+	    xpTracking: XPTracking;
+	    xpRates: XPRates;
+	    playerLevel: number;
+	    playerClass: string;
+	    currentArea: string;
+	    // Go type: time
+	    sessionStartTime: any;
+	    currentRunType: string;
+	    itemsData: ItemsResponse;
+	    qualityCounts: Record<string, number>;
+	    valueStats: ValueStats;
+	    gold: GoldStats;
+	    merc: MercStatus;
+	
+	    static createFrom(source: any = {}) {
+	        return new GameStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.normal = source["normal"];
+	        this.champion = source["champion"];
+	        this.unique = source["unique"];
+	        this.superUnique = source["superUnique"];
+	        this.minion = source["minion"];
+	        this.total = source["total"];
+	        this.currentRun = source["currentRun"];
+	        this.fastestRun = source["fastestRun"];
+	        this.slowestRun = source["slowestRun"];
+	        this.averageRun = source["averageRun"];
+	        this.totalRuns = source["totalRuns"];
+	        this.runActive = source["runActive"];
+	        this.totalItems = source["totalItems"];
+	        this.recentItems = this.convertValues(source["recentItems"], ItemEntry);
+	        this.currentProfile = source["currentProfile"];
+	        this.profiles = source["profiles"];
+	        this.filtersEnabled = source["filtersEnabled"];
+	        this.xpTracking = this.convertValues(source["xpTracking"], XPTracking);
+	        this.xpRates = this.convertValues(source["xpRates"], XPRates);
+	        this.playerLevel = source["playerLevel"];
+	        this.playerClass = source["playerClass"];
+	        this.currentArea = source["currentArea"];
+	        this.sessionStartTime = this.convertValues(source["sessionStartTime"], null);
+	        this.currentRunType = source["currentRunType"];
+	        this.itemsData = this.convertValues(source["itemsData"], ItemsResponse);
+	        this.qualityCounts = source["qualityCounts"];
+	        this.valueStats = this.convertValues(source["valueStats"], ValueStats);
+	        this.gold = this.convertValues(source["gold"], GoldStats);
+	        this.merc = this.convertValues(source["merc"], MercStatus);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	
+	
+	
+	export class ItemListResponse {
+	    unique_items: string[];
+	    set_items: string[];
+	    all_special_items: string[];
+	    total_count: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ItemListResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.unique_items = source["unique_items"];
+	        this.set_items = source["set_items"];
+	        this.all_special_items = source["all_special_items"];
+	        this.total_count = source["total_count"];
+	    }
+	}
+	export class ItemQuery {
+	    quality: string;
+	    search: string;
+	    fuzzy: boolean;
+	    min_run: number;
+	    max_run: number;
+	    // Go type: time
+	    from: any;
+	    // Go type: time
+	    to: any;
+	    ethereal?: boolean;
+	    run_type: string;
+	    area: string;
+	    tags: string[];
+	    favorite: boolean;
+	    status: string;
+	    sort_by: string;
+	    sort_desc: boolean;
+	    page: number;
+	    items_per_page: number;
+	    show_all: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ItemQuery(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.quality = source["quality"];
+	        this.search = source["search"];
+	        this.fuzzy = source["fuzzy"];
+	        this.min_run = source["min_run"];
+	        this.max_run = source["max_run"];
+	        this.from = this.convertValues(source["from"], null);
+	        this.to = this.convertValues(source["to"], null);
+	        this.ethereal = source["ethereal"];
+	        this.run_type = source["run_type"];
+	        this.area = source["area"];
+	        this.tags = source["tags"];
+	        this.favorite = source["favorite"];
+	        this.status = source["status"];
+	        this.sort_by = source["sort_by"];
+	        this.sort_desc = source["sort_desc"];
+	        this.page = source["page"];
+	        this.items_per_page = source["items_per_page"];
+	        this.show_all = source["show_all"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ItemRef {
+	    id: string;
+	    version: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ItemRef(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.version = source["version"];
+	    }
+	}
+	
+	export class KeeperRate {
+	    quality: string;
+	    kept: number;
+	    discarded: number;
+	    keeper_rate: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new KeeperRate(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.quality = source["quality"];
+	        this.kept = source["kept"];
+	        this.discarded = source["discarded"];
+	        this.keeper_rate = source["keeper_rate"];
+	    }
+	}
+	export class KeeperStats {
+	    tracked_items: number;
+	    by_status: Record<string, number>;
+	    kept: number;
+	    discarded: number;
+	    keeper_rate: number;
+	    by_quality: KeeperRate[];
+	
+	    static createFrom(source: any = {}) {
+	        return new KeeperStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.tracked_items = source["tracked_items"];
+	        this.by_status = source["by_status"];
+	        this.kept = source["kept"];
+	        this.discarded = source["discarded"];
+	        this.keeper_rate = source["keeper_rate"];
+	        this.by_quality = this.convertValues(source["by_quality"], KeeperRate);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class LevelUpRecord {
+	    // Go type: time
+	    time: any;
+	    character?: string;
+	    level: number;
+	    run_index: number;
+	    area?: string;
+	    play_time_ms: number;
+	    real_time_ms: number;
+	    runs: number;
+	    complete: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new LevelUpRecord(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.time = this.convertValues(source["time"], null);
+	        this.character = source["character"];
+	        this.level = source["level"];
+	        this.run_index = source["run_index"];
+	        this.area = source["area"];
+	        this.play_time_ms = source["play_time_ms"];
+	        this.real_time_ms = source["real_time_ms"];
+	        this.runs = source["runs"];
+	        this.complete = source["complete"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class LevelingStep {
+	    level: number;
+	    // Go type: time
+	    reached_at: any;
+	    // Go type: time
+	    completed_at: any;
+	    play_time_ms: number;
+	    real_time_ms: number;
+	    runs: number;
+	    level_xp: number;
+	    xp_per_hour: number;
+	    complete: boolean;
+	    in_progress: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new LevelingStep(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.level = source["level"];
+	        this.reached_at = this.convertValues(source["reached_at"], null);
+	        this.completed_at = this.convertValues(source["completed_at"], null);
+	        this.play_time_ms = source["play_time_ms"];
+	        this.real_time_ms = source["real_time_ms"];
+	        this.runs = source["runs"];
+	        this.level_xp = source["level_xp"];
+	        this.xp_per_hour = source["xp_per_hour"];
+	        this.complete = source["complete"];
+	        this.in_progress = source["in_progress"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SignificanceTest {
+	    metric: string;
+	    difference: number;
+	    t: number;
+	    df: number;
+	    p_value: number;
+	    adjusted_p_value: number;
+	    significant: boolean;
+	    verdict: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SignificanceTest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.metric = source["metric"];
+	        this.difference = source["difference"];
+	        this.t = source["t"];
+	        this.df = source["df"];
+	        this.p_value = source["p_value"];
+	        this.adjusted_p_value = source["adjusted_p_value"];
+	        this.significant = source["significant"];
+	        this.verdict = source["verdict"];
+	    }
+	}
+	export class LoadoutMetric {
+	    mean: number;
+	    std_dev: number;
+	    low: number;
+	    high: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new LoadoutMetric(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.mean = source["mean"];
+	        this.std_dev = source["std_dev"];
+	        this.low = source["low"];
+	        this.high = source["high"];
+	    }
+	}
+	export class LoadoutGroup {
+	    loadout: string;
+	    name: string;
+	    runs: number;
+	    minutes: LoadoutMetric;
+	    uniques: LoadoutMetric;
+	    sets: LoadoutMetric;
+	    runes: LoadoutMetric;
+	    xp: LoadoutMetric;
+	    uniques_per_hour: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new LoadoutGroup(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.loadout = source["loadout"];
+	        this.name = source["name"];
+	        this.runs = source["runs"];
+	        this.minutes = this.convertValues(source["minutes"], LoadoutMetric);
+	        this.uniques = this.convertValues(source["uniques"], LoadoutMetric);
+	        this.sets = this.convertValues(source["sets"], LoadoutMetric);
+	        this.runes = this.convertValues(source["runes"], LoadoutMetric);
+	        this.xp = this.convertValues(source["xp"], LoadoutMetric);
+	        this.uniques_per_hour = source["uniques_per_hour"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class LoadoutComparison {
+	    a: LoadoutGroup;
+	    b: LoadoutGroup;
+	    tests: SignificanceTest[];
+	
+	    static createFrom(source: any = {}) {
+	        return new LoadoutComparison(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.a = this.convertValues(source["a"], LoadoutGroup);
+	        this.b = this.convertValues(source["b"], LoadoutGroup);
+	        this.tests = this.convertValues(source["tests"], SignificanceTest);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	export class LoadoutStats {
+	    id: string;
+	    character: string;
+	    name?: string;
+	    items: Record<string, EquippedItem>;
+	    runs: number;
+	    average_minutes: number;
+	    uniques: number;
+	    sets: number;
+	    uniques_per_run: number;
+	    sets_per_run: number;
+	    xp_per_minute: number;
+	    current: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new LoadoutStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.character = source["character"];
+	        this.name = source["name"];
+	        this.items = this.convertValues(source["items"], EquippedItem, true);
+	        this.runs = source["runs"];
+	        this.average_minutes = source["average_minutes"];
+	        this.uniques = source["uniques"];
+	        this.sets = source["sets"];
+	        this.uniques_per_run = source["uniques_per_run"];
+	        this.sets_per_run = source["sets_per_run"];
+	        this.xp_per_minute = source["xp_per_minute"];
+	        this.current = source["current"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class MFBracket {
+	    min_mf: number;
+	    max_mf: number;
+	    runs: number;
+	    uniques: number;
+	    sets: number;
+	    uniques_per_run: number;
+	    sets_per_run: number;
+	    average_mf: number;
+	    average_minutes: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new MFBracket(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.min_mf = source["min_mf"];
+	        this.max_mf = source["max_mf"];
+	        this.runs = source["runs"];
+	        this.uniques = source["uniques"];
+	        this.sets = source["sets"];
+	        this.uniques_per_run = source["uniques_per_run"];
+	        this.sets_per_run = source["sets_per_run"];
+	        this.average_mf = source["average_mf"];
+	        this.average_minutes = source["average_minutes"];
+	    }
+	}
+	export class MercDeath {
+	    // Go type: time
+	    time: any;
+	    run_index: number;
+	    area?: string;
+	    character?: string;
+	    type?: string;
+	    level: number;
+	    detection: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new MercDeath(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.time = this.convertValues(source["time"], null);
+	        this.run_index = source["run_index"];
+	        this.area = source["area"];
+	        this.character = source["character"];
+	        this.type = source["type"];
+	        this.level = source["level"];
+	        this.detection = source["detection"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class MercResurrect {
+	    // Go type: time
+	    time: any;
+	    run_index: number;
+	    character?: string;
+	    gold: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new MercResurrect(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.time = this.convertValues(source["time"], null);
+	        this.run_index = source["run_index"];
+	        this.character = source["character"];
+	        this.gold = source["gold"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class MercLevelRecord {
+	    // Go type: time
+	    time: any;
+	    run_index: number;
+	    character?: string;
+	    type?: string;
+	    level: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new MercLevelRecord(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.time = this.convertValues(source["time"], null);
+	        this.run_index = source["run_index"];
+	        this.character = source["character"];
+	        this.type = source["type"];
+	        this.level = source["level"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class MercHistory {
+	    character: string;
+	    levels: MercLevelRecord[];
+	    gear: Record<string, EquippedItem>;
+	    gear_log: EquipmentChange[];
+	    deaths: MercDeath[];
+	    resurrects: MercResurrect[];
+	    by_area: Record<string, number>;
+	
+	    static createFrom(source: any = {}) {
+	        return new MercHistory(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.character = source["character"];
+	        this.levels = this.convertValues(source["levels"], MercLevelRecord);
+	        this.gear = this.convertValues(source["gear"], EquippedItem, true);
+	        this.gear_log = this.convertValues(source["gear_log"], EquipmentChange);
+	        this.deaths = this.convertValues(source["deaths"], MercDeath);
+	        this.resurrects = this.convertValues(source["resurrects"], MercResurrect);
+	        this.by_area = source["by_area"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	
+	export class PriceTable {
+	    currency: string;
+	    uniques: Record<string, number>;
+	    sets: Record<string, number>;
+	    runes: Record<string, number>;
+	    bases: Record<string, number>;
+	
+	    static createFrom(source: any = {}) {
+	        return new PriceTable(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.currency = source["currency"];
+	        this.uniques = source["uniques"];
+	        this.sets = source["sets"];
+	        this.runes = source["runes"];
+	        this.bases = source["bases"];
+	    }
+	}
+	
+	export class RunRecord {
+	    index: number;
+	    // Go type: time
+	    start: any;
+	    duration_ms: number;
+	    run_type: string;
+	    areas?: Record<string, number>;
+	    gold: GoldBreakdown;
+	    xp: number;
+	    area_xp?: Record<string, number>;
+	    character?: CharacterSnapshot;
+	    loadout?: string;
+	    loadout_tag?: string;
+	    deaths?: number;
+	    merc_deaths?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RunRecord(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.index = source["index"];
+	        this.start = this.convertValues(source["start"], null);
+	        this.duration_ms = source["duration_ms"];
+	        this.run_type = source["run_type"];
+	        this.areas = source["areas"];
+	        this.gold = this.convertValues(source["gold"], GoldBreakdown);
+	        this.xp = source["xp"];
+	        this.area_xp = source["area_xp"];
+	        this.character = this.convertValues(source["character"], CharacterSnapshot);
+	        this.loadout = source["loadout"];
+	        this.loadout_tag = source["loadout_tag"];
+	        this.deaths = source["deaths"];
+	        this.merc_deaths = source["merc_deaths"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	export class RuneBalance {
+	    rune: string;
+	    found: number;
+	    spent_runewords: number;
+	    spent_cube: number;
+	    balance: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RuneBalance(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.rune = source["rune"];
+	        this.found = source["found"];
+	        this.spent_runewords = source["spent_runewords"];
+	        this.spent_cube = source["spent_cube"];
+	        this.balance = source["balance"];
+	    }
+	}
+	export class RunewordRecord {
+	    // Go type: time
+	    time: any;
+	    run_index: number;
+	    area?: string;
+	    runeword: string;
+	    base: string;
+	    ethereal?: boolean;
+	    runes: string[];
+	    stats: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new RunewordRecord(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.time = this.convertValues(source["time"], null);
+	        this.run_index = source["run_index"];
+	        this.area = source["area"];
+	        this.runeword = source["runeword"];
+	        this.base = source["base"];
+	        this.ethereal = source["ethereal"];
+	        this.runes = source["runes"];
+	        this.stats = source["stats"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class RunewordStats {
+	    runewords: number;
+	    by_runeword: Record<string, number>;
+	    runes_found: number;
+	    runes_spent: number;
+	    runes: RuneBalance[];
+	
+	    static createFrom(source: any = {}) {
+	        return new RunewordStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.runewords = source["runewords"];
+	        this.by_runeword = source["by_runeword"];
+	        this.runes_found = source["runes_found"];
+	        this.runes_spent = source["runes_spent"];
+	        this.runes = this.convertValues(source["runes"], RuneBalance);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ShoppingMatch {
+	    // Go type: time
+	    time: any;
+	    rule_id: string;
+	    rule: string;
+	    vendor: string;
+	    item: string;
+	    quality: string;
+	    stats: string[];
+	    run_index: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ShoppingMatch(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.time = this.convertValues(source["time"], null);
+	        this.rule_id = source["rule_id"];
+	        this.rule = source["rule"];
+	        this.vendor = source["vendor"];
+	        this.item = source["item"];
+	        this.quality = source["quality"];
+	        this.stats = source["stats"];
+	        this.run_index = source["run_index"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class StatRule {
+	    text: string;
+	    min: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new StatRule(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.text = source["text"];
+	        this.min = source["min"];
+	    }
+	}
+	export class ShoppingRule {
+	    id: string;
+	    name: string;
+	    enabled: boolean;
+	    vendor: string;
+	    item: string;
+	    quality: string;
+	    min_sockets: number;
+	    ethereal?: boolean;
+	    stats: StatRule[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ShoppingRule(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.enabled = source["enabled"];
+	        this.vendor = source["vendor"];
+	        this.item = source["item"];
+	        this.quality = source["quality"];
+	        this.min_sockets = source["min_sockets"];
+	        this.ethereal = source["ethereal"];
+	        this.stats = this.convertValues(source["stats"], StatRule);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class StashItem {
+	    name: string;
+	    quality: string;
+	    affixes?: string;
+	    location: string;
+	    page: number;
+	    x: number;
+	    y: number;
+	    is_ethereal?: boolean;
+	    is_identified?: boolean;
+	    item_level?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new StashItem(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.quality = source["quality"];
+	        this.affixes = source["affixes"];
+	        this.location = source["location"];
+	        this.page = source["page"];
+	        this.x = source["x"];
+	        this.y = source["y"];
+	        this.is_ethereal = source["is_ethereal"];
+	        this.is_identified = source["is_identified"];
+	        this.item_level = source["item_level"];
+	    }
+	}
+	export class StashChange {
+	    // Go type: time
+	    time: any;
+	    action: string;
+	    item: StashItem;
+	
+	    static createFrom(source: any = {}) {
+	        return new StashChange(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.time = this.convertValues(source["time"], null);
+	        this.action = source["action"];
+	        this.item = this.convertValues(source["item"], StashItem);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class StashView {
+	    character: string;
+	    // Go type: time
+	    updated: any;
+	    gold: number;
+	    shared_gold: number[];
+	    total_items: number;
+	    items: StashItem[];
+	
+	    static createFrom(source: any = {}) {
+	        return new StashView(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.character = source["character"];
+	        this.updated = this.convertValues(source["updated"], null);
+	        this.gold = source["gold"];
+	        this.shared_gold = source["shared_gold"];
+	        this.total_items = source["total_items"];
+	        this.items = this.convertValues(source["items"], StashItem);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class UberItemCount {
+	    name: string;
+	    group: string;
+	    source: string;
+	    found: number;
+	    owned: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new UberItemCount(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.group = source["group"];
+	        this.source = source["source"];
+	        this.found = source["found"];
+	        this.owned = source["owned"];
+	    }
+	}
+	export class UberStats {
+	    items: UberItemCount[];
+	    key_sets: number;
+	    organ_sets: number;
+	    token_sets: number;
+	    progress: string;
+	    character: string;
+	    run_types: RunTypeDrops[];
+	
+	    static createFrom(source: any = {}) {
+	        return new UberStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.items = this.convertValues(source["items"], UberItemCount);
+	        this.key_sets = source["key_sets"];
+	        this.organ_sets = source["organ_sets"];
+	        this.token_sets = source["token_sets"];
+	        this.progress = source["progress"];
+	        this.character = source["character"];
+	        this.run_types = this.convertValues(source["run_types"], RunTypeDrops);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class XPAreaStats {
+	    area: string;
+	    runs: number;
+	    xp: number;
+	    minutes: number;
+	    xp_per_minute: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new XPAreaStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.area = source["area"];
+	        this.runs = source["runs"];
+	        this.xp = source["xp"];
+	        this.minutes = source["minutes"];
+	        this.xp_per_minute = source["xp_per_minute"];
+	    }
+	}
+	export class XPRunTypeStats {
+	    run_type: string;
+	    runs: number;
+	    xp: number;
+	    minutes: number;
+	    xp_per_minute: number;
+	    average_xp_per_run: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new XPRunTypeStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.run_type = source["run_type"];
+	        this.runs = source["runs"];
+	        this.xp = source["xp"];
+	        this.minutes = source["minutes"];
+	        this.xp_per_minute = source["xp_per_minute"];
+	        this.average_xp_per_run = source["average_xp_per_run"];
+	    }
+	}
+	export class XPEfficiency {
+	    areas: XPAreaStats[];
+	    run_types: XPRunTypeStats[];
+	
+	    static createFrom(source: any = {}) {
+	        return new XPEfficiency(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.areas = this.convertValues(source["areas"], XPAreaStats);
+	        this.run_types = this.convertValues(source["run_types"], XPRunTypeStats);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class XPLevel {
+	    level: number;
+	    total_xp: number;
+	    level_xp: number;
+	    xp_to_99: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new XPLevel(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.level = source["level"];
+	        this.total_xp = source["total_xp"];
+	        this.level_xp = source["level_xp"];
+	        this.xp_to_99 = source["xp_to_99"];
+	    }
+	}
+	export class XPRateSettings {
+	    window_minutes: number;
+	    window_runs: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new XPRateSettings(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.window_minutes = source["window_minutes"];
+	        this.window_runs = source["window_runs"];
+	    }
+	}
+	
+	export class XPRunEntry {
+	    run_index: number;
+	    // Go type: time
+	    time: any;
+	    character?: string;
+	    level: number;
+	    xp: number;
+	    duration_ms: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new XPRunEntry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.run_index = source["run_index"];
+	        this.time = this.convertValues(source["time"], null);
+	        this.character = source["character"];
+	        this.level = source["level"];
+	        this.xp = source["xp"];
+	        this.duration_ms = source["duration_ms"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
 	
 
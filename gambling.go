@@ -0,0 +1,235 @@
+// gambling.go - Gambling Session Tracker for D2R Tracker
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/hectorgimenez/d2go/pkg/data/npc"
+	"github.com/hectorgimenez/d2go/pkg/utils"
+)
+
+// ========== GAMBLING STRUCTURES ==========
+
+// GambleRecord is one gambled item; gambles are kept apart from itemHistory (ground drops)
+type GambleRecord struct {
+	Time      time.Time `json:"time"`
+	RunIndex  int       `json:"run_index"`
+	Vendor    string    `json:"vendor"`
+	Base      string    `json:"base"`           // Base item name
+	Quality   string    `json:"quality"`        // Result quality
+	Name      string    `json:"name,omitempty"` // Unique/set name
+	GoldSpent int       `json:"gold_spent"`
+	Value     float64   `json:"value,omitempty"` // Estimated value from the price table
+}
+
+type GamblingStats struct {
+	Gambles    int               `json:"gambles"`
+	GoldSpent  int               `json:"gold_spent"`
+	Uniques    int               `json:"uniques"`
+	Sets       int               `json:"sets"`
+	Rares      int               `json:"rares"`
+	Magic      int               `json:"magic"`
+	TotalValue float64           `json:"total_value"`
+	Currency   string            `json:"currency"`
+	ByBase     []GambleBaseStats `json:"by_base"` // Best return first
+}
+
+type GambleBaseStats struct {
+	Base            string  `json:"base"`
+	Gambles         int     `json:"gambles"`
+	GoldSpent       int     `json:"gold_spent"`
+	Uniques         int     `json:"uniques"`
+	Sets            int     `json:"sets"`
+	Rares           int     `json:"rares"`
+	TotalValue      float64 `json:"total_value"`
+	HitsPerMillion  float64 `json:"hits_per_million"`  // Uniques + sets per 1,000,000 gold
+	ValuePerMillion float64 `json:"value_per_million"` // Estimated value per 1,000,000 gold
+}
+
+// ========== VENDORS ==========
+
+var vendorNames = map[npc.ID]string{
+	npc.Akara: "Akara", npc.Charsi: "Charsi", npc.Gheed: "Gheed",
+	npc.Fara: "Fara", npc.Drognan: "Drognan", npc.Elzix: "Elzix", npc.Lysander: "Lysander",
+	npc.Alkor: "Alkor", npc.Ormus: "Ormus", npc.Asheara: "Asheara", npc.Hratli: "Hratli",
+	npc.Halbu: "Halbu", npc.Jamella: "Jamella",
+	npc.Larzuk: "Larzuk", npc.Malah: "Malah", npc.Drehya: "Anya",
+}
+
+var gamblers = map[npc.ID]bool{
+	npc.Gheed: true, npc.Elzix: true, npc.Fara: true, npc.Jamella: true, npc.Drehya: true,
+}
+
+// getActiveVendor returns the vendor closest to the player while a shop window is open
+func getActiveVendor(gameData data.Data) (npc.ID, bool) {
+	var vendor npc.ID
+	closest := 20 // Tiles; vendors are interacted with from up close
+	found := false
+	for _, monster := range gameData.Monsters {
+		if _, isVendor := vendorNames[monster.Name]; !isVendor {
+			continue
+		}
+		distance := utils.DistanceFromPoint(gameData.PlayerUnit.Position, monster.Position)
+		if distance < closest {
+			vendor, closest, found = monster.Name, distance, true
+		}
+	}
+	return vendor, found
+}
+
+// ========== GAMBLE DETECTION ==========
+
+// recordGambles checks items that appeared while a gambler's shop was open.
+// A bought item that was not listed by the vendor with the same quality came from the gamble window.
+// Must be called with a.mu held.
+func (a *App) recordGambles(gameData data.Data, newItems []data.Item) int {
+	vendor, found := getActiveVendor(gameData)
+	if !found || !gamblers[vendor] {
+		return 0
+	}
+
+	recorded := 0
+	for _, itm := range newItems {
+		if itm.Quality < item.QualityMagic || isListedByVendor(itm, a.lastVendorItems) {
+			continue
+		}
+
+		entry := ItemEntry{
+			Name:         a.getItemName(itm),
+			OriginalName: a.getItemName(itm),
+			Quality:      a.getItemQuality(itm),
+			Affixes:      a.getItemAffixes(itm),
+		}
+		record := GambleRecord{
+			Time:     time.Now(),
+			RunIndex: a.currentRun,
+			Vendor:   vendorNames[vendor],
+			Base:     entry.Name,
+			Quality:  entry.Quality,
+			Value:    a.estimateItemValue(entry),
+		}
+		if itm.IsNamed && (itm.Quality == item.QualityUnique || itm.Quality == item.QualitySet) {
+			record.Name = itm.IdentifiedName
+		}
+
+		a.gambleHistory = append(a.gambleHistory, record)
+		recorded++
+		fmt.Printf("🎲 GAMBLED at %s: %s (%s) %s\n", record.Vendor, record.Base, record.Quality, record.Name)
+	}
+	return recorded
+}
+
+// assignGambleGold splits a gold loss over the gambles still waiting for their price.
+// Must be called with a.mu held.
+func (a *App) assignGambleGold(amount int) {
+	first := len(a.gambleHistory)
+	for first > 0 && a.gambleHistory[first-1].GoldSpent == 0 {
+		first--
+	}
+	pending := a.gambleHistory[first:]
+	if len(pending) == 0 {
+		return
+	}
+
+	share := amount / len(pending)
+	for i := range pending {
+		pending[i].GoldSpent = share
+	}
+	pending[len(pending)-1].GoldSpent += amount - share*len(pending)
+}
+
+func isListedByVendor(itm data.Item, vendorItems []data.Item) bool {
+	for _, listed := range vendorItems {
+		if listed.Name == itm.Name && listed.Quality == itm.Quality && listed.IdentifiedName == itm.IdentifiedName {
+			return true
+		}
+	}
+	return false
+}
+
+func getVendorItems(gameData data.Data) []data.Item {
+	var vendorItems []data.Item
+	for _, itm := range gameData.Inventory.AllItems {
+		if itm.Location.LocationType == item.LocationVendor {
+			vendorItems = append(vendorItems, itm)
+		}
+	}
+	return vendorItems
+}
+
+// ========== GAMBLING API ==========
+
+// GetGambleHistory returns the newest gambles first; limit <= 0 returns all
+func (a *App) GetGambleHistory(limit int) []GambleRecord {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	records := make([]GambleRecord, 0, len(a.gambleHistory))
+	for i := len(a.gambleHistory) - 1; i >= 0; i-- {
+		if limit > 0 && len(records) >= limit {
+			break
+		}
+		records = append(records, a.gambleHistory[i])
+	}
+	return records
+}
+
+func (a *App) GetGamblingStats() GamblingStats {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	stats := GamblingStats{Currency: a.priceTable.Currency, ByBase: []GambleBaseStats{}}
+	byBase := make(map[string]*GambleBaseStats)
+	for _, record := range a.gambleHistory {
+		base, found := byBase[record.Base]
+		if !found {
+			base = &GambleBaseStats{Base: record.Base}
+			byBase[record.Base] = base
+		}
+
+		stats.Gambles++
+		stats.GoldSpent += record.GoldSpent
+		stats.TotalValue += record.Value
+		base.Gambles++
+		base.GoldSpent += record.GoldSpent
+		base.TotalValue += record.Value
+
+		switch record.Quality {
+		case "Unique":
+			stats.Uniques++
+			base.Uniques++
+		case "Set":
+			stats.Sets++
+			base.Sets++
+		case "Rare":
+			stats.Rares++
+			base.Rares++
+		case "Magic":
+			stats.Magic++
+		}
+	}
+
+	for _, base := range byBase {
+		if base.GoldSpent > 0 {
+			millions := float64(base.GoldSpent) / 1000000
+			base.HitsPerMillion = float64(base.Uniques+base.Sets) / millions
+			base.ValuePerMillion = base.TotalValue / millions
+		}
+		stats.ByBase = append(stats.ByBase, *base)
+	}
+	sort.Slice(stats.ByBase, func(i, j int) bool {
+		x, y := stats.ByBase[i], stats.ByBase[j]
+		if x.ValuePerMillion != y.ValuePerMillion {
+			return x.ValuePerMillion > y.ValuePerMillion
+		}
+		if x.HitsPerMillion != y.HitsPerMillion {
+			return x.HitsPerMillion > y.HitsPerMillion
+		}
+		return x.Base < y.Base
+	})
+	return stats
+}
@@ -0,0 +1,247 @@
+// gold.go - Gold Income & Expense Tracking for D2R Tracker
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/hectorgimenez/d2go/pkg/data/mode"
+)
+
+// A shop loss without a new item waits this long for the bought or gambled item to appear
+const shopLossWindow = 2 * time.Second
+
+// ========== GOLD STRUCTURES ==========
+
+// GoldBreakdown splits gold changes by cause; all values are positive amounts
+type GoldBreakdown struct {
+	// Income
+	Pickup int `json:"pickup"` // Gold picked up from the ground
+	Vendor int `json:"vendor"` // Items sold to vendors
+	// Expenses
+	Repair   int `json:"repair"`   // Shop visits without a new item
+	Shopping int `json:"shopping"` // Items bought from vendors
+	Gambling int `json:"gambling"` // Items bought in the gamble window
+	Merc     int `json:"merc"`     // Mercenary resurrection
+	Death    int `json:"death"`    // Gold lost when dying
+	Other    int `json:"other"`    // Any other loss (e.g. gold dropped)
+}
+
+type GoldStats struct {
+	CurrentGold    int           `json:"currentGold"` // Inventory + stash gold
+	SessionIncome  int           `json:"sessionIncome"`
+	SessionExpense int           `json:"sessionExpense"`
+	SessionNet     int           `json:"sessionNet"`
+	GoldPerHour    float64       `json:"goldPerHour"` // Net session gold per hour in game
+	Session        GoldBreakdown `json:"session"`
+	CurrentRun     GoldBreakdown `json:"currentRun"`
+}
+
+func (g GoldBreakdown) Income() int {
+	return g.Pickup + g.Vendor
+}
+
+func (g GoldBreakdown) Expense() int {
+	return g.Repair + g.Shopping + g.Gambling + g.Merc + g.Death + g.Other
+}
+
+func (g GoldBreakdown) Net() int {
+	return g.Income() - g.Expense()
+}
+
+func (g *GoldBreakdown) add(cause string, amount int) {
+	switch cause {
+	case "pickup":
+		g.Pickup += amount
+	case "vendor":
+		g.Vendor += amount
+	case "repair":
+		g.Repair += amount
+	case "shopping":
+		g.Shopping += amount
+	case "gambling":
+		g.Gambling += amount
+	case "merc":
+		g.Merc += amount
+	case "death":
+		g.Death += amount
+	default:
+		g.Other += amount
+	}
+}
+
+// ========== GOLD SAMPLING ==========
+
+func (a *App) updateGoldTracking() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	gameData := a.lastGameData
+	if a.wasInMenu || !a.runActive || gameData.PlayerUnit.Area == 0 {
+		return
+	}
+
+	// Time in game for gold per hour; long gaps are menus or loading screens
+	now := time.Now()
+	if elapsed := now.Sub(a.lastGoldTick); elapsed > 0 && elapsed <= time.Second {
+		a.goldActiveTime += elapsed
+	}
+	a.lastGoldTick = now
+
+	gold := totalGold(gameData)
+	carried := carriedItemIDs(gameData)
+	shopOpen := gameData.OpenMenus.NPCShop || a.goldShopOpen
+	mercRevived := gameData.HasMerc && !a.goldHadMerc
+
+	// Remember when an item was bought so a following gold loss counts as shopping or gambling
+	if a.goldSampled && shopOpen {
+		var newItems []data.Item
+		for _, itm := range gameData.Inventory.AllItems {
+			if carried[itm.UnitID] && !a.goldCarriedItems[itm.UnitID] {
+				newItems = append(newItems, itm)
+			}
+		}
+		if len(newItems) > 0 {
+			a.lastShopPurchase = time.Now()
+			if a.recordGambles(gameData, newItems) > 0 {
+				a.lastGamble = time.Now()
+			}
+		}
+	}
+	a.lastVendorItems = getVendorItems(gameData)
+
+	// A shop loss held back on an earlier tick belongs to the item that appeared since
+	if a.pendingShopLoss > 0 {
+		switch {
+		case time.Since(a.lastGamble) < time.Second:
+			a.settleShopLoss("gambling")
+		case time.Since(a.lastShopPurchase) < time.Second:
+			a.settleShopLoss("shopping")
+		case !shopOpen || time.Since(a.pendingShopSince) >= shopLossWindow:
+			a.settleShopLoss("repair")
+		}
+	}
+
+	previous := a.lastGold
+	sampled := a.goldSampled
+	a.lastGold = gold
+	a.goldSampled = true
+	a.goldShopOpen = gameData.OpenMenus.NPCShop
+	a.goldHadMerc = gameData.HasMerc
+	a.goldCarriedItems = carried
+
+	if !sampled || gold == previous {
+		return
+	}
+
+	delta := gold - previous
+	var cause string
+	switch {
+	case delta > 0 && shopOpen:
+		cause = "vendor"
+	case delta > 0:
+		cause = "pickup"
+	case gameData.PlayerUnit.Mode == mode.Dead || a.recentDeath():
+		cause = "death"
+		a.addDeathLoss(0, -delta)
+	case mercRevived:
+		cause = "merc"
+		a.recordMercResurrect(-delta)
+	case shopOpen && time.Since(a.lastGamble) < time.Second:
+		cause = "gambling"
+		a.assignGambleGold(-delta)
+	case shopOpen && time.Since(a.lastShopPurchase) < time.Second:
+		cause = "shopping"
+	case shopOpen:
+		// Repair, or an item that shows up on a later tick than the gold change
+		if a.pendingShopLoss == 0 {
+			a.pendingShopSince = time.Now()
+		}
+		a.pendingShopLoss -= delta
+		return
+	default:
+		cause = "other"
+	}
+
+	a.bookGold(cause, delta)
+	fmt.Printf("💰 Gold %+d (%s) - now %d\n", delta, cause, gold)
+}
+
+// bookGold adds a gold change to the run and session breakdown. Must be called with a.mu held.
+func (a *App) bookGold(cause string, delta int) {
+	amount := delta
+	if amount < 0 {
+		amount = -amount
+	}
+	a.runGold.add(cause, amount)
+	a.sessionGold.add(cause, amount)
+}
+
+// settleShopLoss books the held back shop loss. Must be called with a.mu held.
+func (a *App) settleShopLoss(cause string) {
+	amount := a.pendingShopLoss
+	a.pendingShopLoss = 0
+	if cause == "gambling" {
+		a.assignGambleGold(amount)
+	}
+	a.bookGold(cause, -amount)
+	fmt.Printf("💰 Gold %+d (%s) - now %d\n", -amount, cause, a.lastGold)
+}
+
+// resetGoldSampling starts a new run's gold breakdown. Must be called with a.mu held.
+func (a *App) resetGoldSampling() {
+	if a.pendingShopLoss > 0 {
+		a.settleShopLoss("repair")
+	}
+	a.runGold = GoldBreakdown{}
+	a.goldSampled = false
+	a.goldShopOpen = false
+	a.goldHadMerc = false
+	a.goldCarriedItems = nil
+	a.lastVendorItems = nil
+}
+
+// getGoldStats summarizes session and run gold. Must be called with a.mu held.
+func (a *App) getGoldStats() GoldStats {
+	stats := GoldStats{
+		CurrentGold:    a.lastGold,
+		SessionIncome:  a.sessionGold.Income(),
+		SessionExpense: a.sessionGold.Expense(),
+		SessionNet:     a.sessionGold.Net(),
+		Session:        a.sessionGold,
+		CurrentRun:     a.runGold,
+	}
+
+	// Per hour in game, so menus and breaks don't lower the rate. Skip the first minute,
+	// where a single pickup would extrapolate to millions per hour.
+	if a.goldActiveTime >= time.Minute {
+		stats.GoldPerHour = float64(stats.SessionNet) / a.goldActiveTime.Hours()
+	}
+	return stats
+}
+
+// ========== GOLD HELPERS ==========
+
+// totalGold is the character's gold in the inventory, personal stash and shared stash
+func totalGold(gameData data.Data) int {
+	gold := gameData.Inventory.Gold
+	for _, stashed := range gameData.Inventory.StashedGold {
+		gold += stashed
+	}
+	return gold
+}
+
+// carriedItemIDs lists the unit IDs of all items owned by the character
+func carriedItemIDs(gameData data.Data) map[data.UnitID]bool {
+	carried := make(map[data.UnitID]bool, len(gameData.Inventory.AllItems))
+	for _, itm := range gameData.Inventory.AllItems {
+		switch itm.Location.LocationType {
+		case item.LocationVendor, item.LocationGround:
+			continue
+		}
+		carried[itm.UnitID] = true
+	}
+	return carried
+}
@@ -0,0 +1,451 @@
+// item_edits.go - Item Deletion, Bulk Edits & Undoable Audit Log for D2R Tracker
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const maxAuditEntries = 500 // Audit log entries kept per profile
+
+// ========== AUDIT LOG STRUCTURES ==========
+type ItemRef struct {
+	ID      string `json:"id"`
+	Version int    `json:"version"` // Version the caller has seen
+}
+
+type ItemChange struct {
+	Position int        `json:"position"`         // Index in itemHistory before the change
+	Before   *ItemEntry `json:"before,omitempty"` // nil = item was created
+	After    *ItemEntry `json:"after,omitempty"`  // nil = item was deleted
+}
+
+type AuditEntry struct {
+	ID          int          `json:"id"`
+	Time        time.Time    `json:"time"`
+	Action      string       `json:"action"` // rename, delete, bulk_rename, bulk_quality, merge, ...
+	Description string       `json:"description"`
+	Changes     []ItemChange `json:"changes"`
+	Undone      bool         `json:"undone"`
+	Discarded   bool         `json:"discarded,omitempty"` // Undone and replaced by a newer change (no redo)
+}
+
+// ========== DELETE & BULK EDIT API ==========
+
+func (a *App) DeleteItem(itemID string, expectedVersion int) error {
+	return a.DeleteItems([]ItemRef{{ID: itemID, Version: expectedVersion}})
+}
+
+func (a *App) DeleteItems(refs []ItemRef) error {
+	return a.modifyItems("delete", refs, func(item *ItemEntry) bool {
+		return false // Remove item
+	}, fmt.Sprintf("Deleted %d item(s)", len(refs)))
+}
+
+func (a *App) BulkRenameItems(refs []ItemRef, newName string) error {
+	newName = strings.TrimSpace(newName)
+	if newName == "" {
+		return fmt.Errorf("new name cannot be empty")
+	}
+
+	return a.modifyItems("bulk_rename", refs, func(item *ItemEntry) bool {
+		item.Name = newName
+		return true
+	}, fmt.Sprintf("Renamed %d item(s) to '%s'", len(refs), newName))
+}
+
+func (a *App) BulkSetItemQuality(refs []ItemRef, quality string) error {
+	normalized := normalizeItemQuality(quality)
+	if normalized == "" {
+		return fmt.Errorf("unknown item quality: %s", quality)
+	}
+
+	return a.modifyItems("bulk_quality", refs, func(item *ItemEntry) bool {
+		item.Quality = normalized
+		return true
+	}, fmt.Sprintf("Changed quality of %d item(s) to %s", len(refs), normalized))
+}
+
+// MergeDuplicateItems keeps the oldest of the given items (merging tags, notes and favorites) and deletes the rest
+func (a *App) MergeDuplicateItems(refs []ItemRef) error {
+	if len(refs) < 2 {
+		return fmt.Errorf("select at least two items to merge")
+	}
+
+	a.mu.Lock()
+	indices, err := a.resolveItemRefs(refs)
+	if err != nil {
+		a.mu.Unlock()
+		return err
+	}
+
+	sort.Ints(indices)
+	keepIndex := indices[0]
+	kept := a.itemHistory[keepIndex]
+	merged := cloneItemEntry(kept)
+	for _, idx := range indices[1:] {
+		duplicate := a.itemHistory[idx]
+		merged.Tags = mergeTags(merged.Tags, duplicate.Tags)
+		merged.Note = mergeNotes(merged.Note, duplicate.Note)
+		merged.Favorite = merged.Favorite || duplicate.Favorite
+	}
+
+	changes := []ItemChange{{Position: keepIndex, Before: cloneItemEntryPtr(kept), After: &merged}}
+	for _, idx := range indices[1:] {
+		changes = append(changes, ItemChange{Position: idx, Before: cloneItemEntryPtr(a.itemHistory[idx])})
+	}
+
+	entry, err := a.recordItemChanges("merge",
+		fmt.Sprintf("Merged %d duplicates into '%s'", len(indices), kept.Name), changes)
+	a.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("🔗 %s (Audit #%d)\n", entry.Description, entry.ID)
+	a.saveAfterItemEdit()
+	return nil
+}
+
+// FindDuplicateItems groups entries with the same name picked up within a few seconds in the same run
+func (a *App) FindDuplicateItems() [][]ItemEntry {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	var groups [][]ItemEntry
+	used := make(map[int]bool)
+	for i, item := range a.itemHistory {
+		if used[i] {
+			continue
+		}
+		group := []ItemEntry{item}
+		for j := i + 1; j < len(a.itemHistory); j++ {
+			other := a.itemHistory[j]
+			if used[j] || other.RunIndex != item.RunIndex || other.OriginalName != item.OriginalName {
+				continue
+			}
+			if other.Time.Sub(item.Time).Abs() <= 5*time.Second {
+				group = append(group, other)
+				used[j] = true
+			}
+		}
+		if len(group) > 1 {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
+
+// ========== UNDO / REDO API ==========
+
+func (a *App) UndoItemChange() (AuditEntry, error) {
+	a.mu.Lock()
+	entryIndex := -1
+	for i := len(a.auditLog) - 1; i >= 0; i-- {
+		if !a.auditLog[i].Undone {
+			entryIndex = i
+			break
+		}
+	}
+	if entryIndex < 0 {
+		a.mu.Unlock()
+		return AuditEntry{}, fmt.Errorf("nothing to undo")
+	}
+
+	entry := &a.auditLog[entryIndex]
+	if err := a.applyItemChanges(entry.Changes, true); err != nil {
+		a.mu.Unlock()
+		return AuditEntry{}, err
+	}
+	entry.Undone = true
+	result := *entry
+	a.mu.Unlock()
+
+	fmt.Printf("↩️ UNDO: %s (Audit #%d)\n", result.Description, result.ID)
+	a.saveAfterItemEdit()
+	return result, nil
+}
+
+func (a *App) RedoItemChange() (AuditEntry, error) {
+	a.mu.Lock()
+	entryIndex := -1
+	for i := range a.auditLog {
+		if a.auditLog[i].Undone && !a.auditLog[i].Discarded {
+			entryIndex = i
+			break
+		}
+	}
+	if entryIndex < 0 {
+		a.mu.Unlock()
+		return AuditEntry{}, fmt.Errorf("nothing to redo")
+	}
+
+	entry := &a.auditLog[entryIndex]
+	if err := a.applyItemChanges(entry.Changes, false); err != nil {
+		a.mu.Unlock()
+		return AuditEntry{}, err
+	}
+	entry.Undone = false
+	result := *entry
+	a.mu.Unlock()
+
+	fmt.Printf("↪️ REDO: %s (Audit #%d)\n", result.Description, result.ID)
+	a.saveAfterItemEdit()
+	return result, nil
+}
+
+func (a *App) GetItemAuditLog() []AuditEntry {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	entries := make([]AuditEntry, len(a.auditLog))
+	copy(entries, a.auditLog)
+	return entries
+}
+
+// ========== AUDIT LOG HELPERS ==========
+
+// modifyItems applies edit to every referenced item; edit returns false to delete the item
+func (a *App) modifyItems(action string, refs []ItemRef, edit func(item *ItemEntry) bool, description string) error {
+	return a.modifyItemsThen(action, refs, edit, description, nil)
+}
+
+// modifyItemsThen is modifyItems with a follow-up change (e.g. to the tag vocabulary) that is
+// applied under the same lock, and only when the items were changed
+func (a *App) modifyItemsThen(action string, refs []ItemRef, edit func(item *ItemEntry) bool, description string, then func()) error {
+	if len(refs) == 0 {
+		return fmt.Errorf("no items selected")
+	}
+
+	a.mu.Lock()
+	indices, err := a.resolveItemRefs(refs)
+	if err != nil {
+		a.mu.Unlock()
+		fmt.Printf("❌ %s ERROR: %v\n", strings.ToUpper(action), err)
+		return err
+	}
+
+	changes := make([]ItemChange, 0, len(indices))
+	for _, idx := range indices {
+		change := ItemChange{Position: idx, Before: cloneItemEntryPtr(a.itemHistory[idx])}
+		after := cloneItemEntry(a.itemHistory[idx])
+		if edit(&after) {
+			after.Value = a.estimateItemValue(after)
+			change.After = &after
+		}
+		changes = append(changes, change)
+	}
+
+	entry, err := a.recordItemChanges(action, description, changes)
+	if err == nil && then != nil {
+		then()
+	}
+	a.mu.Unlock()
+	if err != nil {
+		fmt.Printf("❌ %s ERROR: %v\n", strings.ToUpper(action), err)
+		return err
+	}
+
+	fmt.Printf("✅ %s (Audit #%d)\n", entry.Description, entry.ID)
+	a.saveAfterItemEdit()
+	return nil
+}
+
+// resolveItemRefs maps refs to itemHistory indices, checking every version. Must be called with a.mu held.
+func (a *App) resolveItemRefs(refs []ItemRef) ([]int, error) {
+	seen := make(map[string]bool, len(refs))
+	indices := make([]int, 0, len(refs))
+	for _, ref := range refs {
+		if seen[ref.ID] {
+			continue
+		}
+		seen[ref.ID] = true
+
+		idx, err := a.getItemForUpdate(ref.ID, ref.Version)
+		if err != nil {
+			return nil, err
+		}
+		indices = append(indices, idx)
+	}
+	return indices, nil
+}
+
+// recordItemChanges applies changes and appends them to the audit log. Must be called with a.mu held.
+func (a *App) recordItemChanges(action, description string, changes []ItemChange) (AuditEntry, error) {
+	if err := a.applyItemChanges(changes, false); err != nil {
+		return AuditEntry{}, err
+	}
+
+	// A new change makes pending redos impossible
+	for i := range a.auditLog {
+		if a.auditLog[i].Undone {
+			a.auditLog[i].Discarded = true
+		}
+	}
+
+	a.nextAuditID++
+	entry := AuditEntry{
+		ID:          a.nextAuditID,
+		Time:        time.Now(),
+		Action:      action,
+		Description: description,
+		Changes:     changes,
+	}
+	a.auditLog = append(a.auditLog, entry)
+	if len(a.auditLog) > maxAuditEntries {
+		a.auditLog = a.auditLog[len(a.auditLog)-maxAuditEntries:]
+	}
+	return entry, nil
+}
+
+// applyItemChanges moves items from one snapshot side to the other (Before -> After, or back on undo).
+// Every affected item must still be in the expected state; otherwise nothing is changed.
+// Must be called with a.mu held.
+func (a *App) applyItemChanges(changes []ItemChange, undo bool) error {
+	from := func(c *ItemChange) *ItemEntry {
+		if undo {
+			return c.After
+		}
+		return c.Before
+	}
+	to := func(c *ItemChange) **ItemEntry {
+		if undo {
+			return &c.Before
+		}
+		return &c.After
+	}
+
+	// Validate everything first so a conflict leaves the history untouched
+	for i := range changes {
+		expected := from(&changes[i])
+		target := *to(&changes[i])
+		if expected == nil {
+			if _, exists := a.findItemIndex(target.ID); exists {
+				return fmt.Errorf("conflict: item '%s' already exists", target.Name)
+			}
+			continue
+		}
+		if _, err := a.getItemForUpdate(expected.ID, expected.Version); err != nil {
+			return err
+		}
+	}
+
+	// Replace changed items and remove deleted ones
+	deleted := make(map[string]bool)
+	for i := range changes {
+		expected := from(&changes[i])
+		target := to(&changes[i])
+		if expected == nil {
+			continue
+		}
+		idx, _ := a.findItemIndex(expected.ID)
+		if *target == nil {
+			deleted[expected.ID] = true
+			continue
+		}
+		updated := cloneItemEntry(**target)
+		keepTrackedFields(&updated, a.itemHistory[idx])
+		updated.Value = a.estimateItemValue(updated) // Prices may have changed since the snapshot
+		updated.Version = a.itemHistory[idx].Version + 1
+		a.itemHistory[idx] = updated
+		(*target).Version = updated.Version
+	}
+	if len(deleted) > 0 {
+		kept := a.itemHistory[:0]
+		for _, item := range a.itemHistory {
+			if !deleted[item.ID] {
+				kept = append(kept, item)
+			}
+		}
+		a.itemHistory = kept
+	}
+
+	// Re-insert restored items at their original positions (ascending)
+	var inserts []*ItemChange
+	for i := range changes {
+		if from(&changes[i]) == nil {
+			inserts = append(inserts, &changes[i])
+		}
+	}
+	sort.Slice(inserts, func(i, j int) bool { return inserts[i].Position < inserts[j].Position })
+	for _, change := range inserts {
+		target := to(change)
+		restored := cloneItemEntry(**target)
+		restored.Value = a.estimateItemValue(restored)
+		restored.Version++
+		(*target).Version = restored.Version
+
+		pos := change.Position
+		if pos > len(a.itemHistory) {
+			pos = len(a.itemHistory)
+		}
+		a.itemHistory = append(a.itemHistory, ItemEntry{})
+		copy(a.itemHistory[pos+1:], a.itemHistory[pos:])
+		a.itemHistory[pos] = restored
+	}
+
+	return nil
+}
+
+func (a *App) saveAfterItemEdit() {
+	if err := a.saveCurrentProfileSync(); err != nil {
+		fmt.Printf("⚠️ SAVE WARNING: Could not save profile: %v\n", err)
+	}
+}
+
+func cloneItemEntry(item ItemEntry) ItemEntry {
+	item.Tags = append([]string(nil), item.Tags...)
+	item.Lifecycle = append([]ItemEvent(nil), item.Lifecycle...)
+	if item.Charm != nil {
+		charm := *item.Charm
+		charm.Rolls = append([]string(nil), charm.Rolls...)
+		item.Charm = &charm
+	}
+	return item
+}
+
+// keepTrackedFields carries over what the trackers learned since the snapshot was taken
+func keepTrackedFields(item *ItemEntry, current ItemEntry) {
+	item.RunType = current.RunType
+	item.Status = current.Status
+	item.Lifecycle = append([]ItemEvent(nil), current.Lifecycle...)
+	item.TrackKey = current.TrackKey
+	item.Charm = current.Charm
+}
+
+func cloneItemEntryPtr(item ItemEntry) *ItemEntry {
+	clone := cloneItemEntry(item)
+	return &clone
+}
+
+// mergeTags returns the case-insensitive union of two tag lists
+func mergeTags(tags, more []string) []string {
+	for _, tag := range more {
+		found := false
+		for _, existing := range tags {
+			if strings.EqualFold(existing, tag) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// mergeNotes appends a duplicate's note unless it is empty or already part of the kept note
+func mergeNotes(note, more string) string {
+	more = strings.TrimSpace(more)
+	switch {
+	case more == "" || strings.Contains(note, more):
+		return note
+	case strings.TrimSpace(note) == "":
+		return more
+	default:
+		return note + "; " + more
+	}
+}
@@ -0,0 +1,282 @@
+// item_query.go - Server-side Item History Queries for D2R Tracker
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ========== ITEM QUERY STRUCTURES ==========
+type ItemQuery struct {
+	Quality  string    `json:"quality"`  // Exact quality ("" = all)
+	Search   string    `json:"search"`   // Full-text search over name, affixes, area, tags and note
+	Fuzzy    bool      `json:"fuzzy"`    // Allow typos and abbreviations in Search
+	MinRun   int       `json:"min_run"`  // First run to include (0 = no limit)
+	MaxRun   int       `json:"max_run"`  // Last run to include (0 = no limit)
+	From     time.Time `json:"from"`     // Earliest pickup time (zero = no limit)
+	To       time.Time `json:"to"`       // Latest pickup time (zero = no limit)
+	Ethereal *bool     `json:"ethereal"` // nil = ethereal and non-ethereal
+	RunType  string    `json:"run_type"` // Exact run type ("" = all)
+	Area     string    `json:"area"`     // Exact pickup area ("" = all)
+	Tags     []string  `json:"tags"`     // Items must carry all of these tags
+	Favorite bool      `json:"favorite"` // Only favorite items
+	Status   string    `json:"status"`   // Exact lifecycle status ("" = all)
+
+	SortBy       string `json:"sort_by"`        // Key of itemSortKeys ("" = newest first)
+	SortDesc     bool   `json:"sort_desc"`      // Descending order
+	Page         int    `json:"page"`           // Page (0-based)
+	ItemsPerPage int    `json:"items_per_page"` // Items per page (0 = current setting)
+	ShowAll      bool   `json:"show_all"`       // Return all matches on one page
+}
+
+// ========== ITEM QUERY API ==========
+
+// QueryItems filters, sorts and pages the item history; an unknown sort key is an error
+func (a *App) QueryItems(query ItemQuery) (ItemsResponse, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	quality := normalizeItemQuality(query.Quality)
+	if quality == "" {
+		quality = strings.TrimSpace(query.Quality) // Qualities outside the list, e.g. "Unknown" on legacy items
+	}
+	searchTerms := strings.Fields(strings.ToLower(query.Search))
+
+	indices := make([]int, 0, len(a.itemHistory))
+	for i, item := range a.itemHistory {
+		if quality != "" && !strings.EqualFold(item.Quality, quality) {
+			continue
+		}
+		if query.MinRun > 0 && item.RunIndex < query.MinRun {
+			continue
+		}
+		if query.MaxRun > 0 && item.RunIndex > query.MaxRun {
+			continue
+		}
+		if !query.From.IsZero() && item.Time.Before(query.From) {
+			continue
+		}
+		if !query.To.IsZero() && item.Time.After(query.To) {
+			continue
+		}
+		if query.Ethereal != nil && item.IsEthereal != *query.Ethereal {
+			continue
+		}
+		if query.RunType != "" && !strings.EqualFold(item.RunType, query.RunType) {
+			continue
+		}
+		if query.Area != "" && !strings.EqualFold(item.Area, query.Area) {
+			continue
+		}
+		if query.Favorite && !item.Favorite {
+			continue
+		}
+		if query.Status != "" && !strings.EqualFold(item.Status, query.Status) {
+			continue
+		}
+		if !itemHasAllTags(item, query.Tags) {
+			continue
+		}
+		if !matchesItemSearch(item, searchTerms, query.Fuzzy) {
+			continue
+		}
+		indices = append(indices, i)
+	}
+
+	if err := a.sortItemIndices(indices, query.SortBy, query.SortDesc); err != nil {
+		return ItemsResponse{}, err
+	}
+
+	fmt.Printf("🔎 QueryItems: %d of %d items match (sort=%s, desc=%t)\n",
+		len(indices), len(a.itemHistory), query.SortBy, query.SortDesc)
+
+	itemsPerPage := query.ItemsPerPage
+	if itemsPerPage <= 0 {
+		itemsPerPage = a.itemsPerPage
+	}
+	response := a.buildItemsPage(indices, query.Page, itemsPerPage, query.ShowAll)
+	response.QualityFilter = quality
+	response.TagFilter = strings.Join(query.Tags, ", ")
+	return response, nil
+}
+
+// ========== QUERY HELPERS ==========
+
+// itemSortKeys are the sortable item fields (ascending order; false sorts before true)
+var itemSortKeys = map[string]func(x, y ItemEntry) bool{
+	"name":       func(x, y ItemEntry) bool { return strings.ToLower(x.Name) < strings.ToLower(y.Name) },
+	"quality":    func(x, y ItemEntry) bool { return qualityRank(x.Quality) < qualityRank(y.Quality) },
+	"run":        func(x, y ItemEntry) bool { return x.RunIndex < y.RunIndex },
+	"time":       func(x, y ItemEntry) bool { return x.Time.Before(y.Time) },
+	"area":       func(x, y ItemEntry) bool { return x.Area < y.Area },
+	"run_type":   func(x, y ItemEntry) bool { return x.RunType < y.RunType },
+	"item_level": func(x, y ItemEntry) bool { return x.ItemLevel < y.ItemLevel },
+	"status":     func(x, y ItemEntry) bool { return x.Status < y.Status },
+	"ethereal":   func(x, y ItemEntry) bool { return !x.IsEthereal && y.IsEthereal },
+	"identified": func(x, y ItemEntry) bool { return !x.IsIdentified && y.IsIdentified },
+	"favorite":   func(x, y ItemEntry) bool { return !x.Favorite && y.Favorite },
+	"value":      func(x, y ItemEntry) bool { return x.Value < y.Value },
+}
+
+// sortItemIndices orders itemHistory indices; the default is newest first
+func (a *App) sortItemIndices(indices []int, sortBy string, desc bool) error {
+	if sortBy == "" {
+		sortBy, desc = "time", true
+	}
+	less, found := itemSortKeys[strings.ToLower(sortBy)]
+	if !found {
+		return fmt.Errorf("unknown sort key: %s", sortBy)
+	}
+
+	sort.SliceStable(indices, func(i, j int) bool {
+		x, y := a.itemHistory[indices[i]], a.itemHistory[indices[j]]
+		if desc {
+			return less(y, x)
+		}
+		return less(x, y)
+	})
+	return nil
+}
+
+// buildItemsPage turns ordered itemHistory indices into one ItemsResponse page
+func (a *App) buildItemsPage(indices []int, page int, itemsPerPage int, showAll bool) ItemsResponse {
+	totalItems := len(indices)
+	if showAll && totalItems > 0 {
+		itemsPerPage = totalItems
+	}
+
+	totalPages := 0
+	if totalItems > 0 {
+		totalPages = (totalItems + itemsPerPage - 1) / itemsPerPage
+	}
+	if page >= totalPages {
+		page = totalPages - 1
+	}
+	if page < 0 {
+		page = 0
+	}
+
+	start := page * itemsPerPage
+	end := start + itemsPerPage
+	if end > totalItems {
+		end = totalItems
+	}
+
+	pageItems := make([]ItemEntry, 0, end-start)
+	for _, idx := range indices[start:end] {
+		pageItems = append(pageItems, a.itemHistory[idx])
+	}
+
+	return ItemsResponse{
+		Items:        pageItems,
+		TotalItems:   totalItems,
+		CurrentPage:  page,
+		ItemsPerPage: itemsPerPage,
+		TotalPages:   totalPages,
+		ShowAll:      showAll,
+	}
+}
+
+func qualityRank(quality string) int {
+	for i, q := range itemQualities {
+		if q == quality {
+			return i
+		}
+	}
+	return len(itemQualities) // Unknown last
+}
+
+func itemHasAllTags(item ItemEntry, tags []string) bool {
+	for _, wanted := range tags {
+		found := false
+		for _, tag := range item.Tags {
+			if strings.EqualFold(tag, wanted) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesItemSearch requires every search term to match the item's searchable text
+func matchesItemSearch(item ItemEntry, terms []string, fuzzy bool) bool {
+	if len(terms) == 0 {
+		return true
+	}
+
+	text := strings.ToLower(strings.Join([]string{
+		item.Name, item.OriginalName, item.Quality, item.Affixes, item.Area, item.RunType, strings.Join(item.Tags, " "), item.Note, item.Status,
+	}, " "))
+	words := strings.Fields(text)
+
+	for _, term := range terms {
+		if strings.Contains(text, term) {
+			continue
+		}
+		if !fuzzy || !fuzzyMatchesAnyWord(term, words) {
+			return false
+		}
+	}
+	return true
+}
+
+// fuzzyMatchesAnyWord accepts abbreviations ("shko" -> "shako") and small typos ("harelquin")
+func fuzzyMatchesAnyWord(term string, words []string) bool {
+	maxDistance := len([]rune(term)) / 4
+	if maxDistance < 1 {
+		maxDistance = 1
+	}
+
+	for _, word := range words {
+		if isSubsequence(term, word) || levenshtein(term, word) <= maxDistance {
+			return true
+		}
+	}
+	return false
+}
+
+func isSubsequence(term, word string) bool {
+	termRunes := []rune(term)
+	if len(termRunes) < 3 {
+		return false // Too short to be a meaningful abbreviation
+	}
+
+	pos := 0
+	for _, r := range word {
+		if r == termRunes[pos] {
+			pos++
+			if pos == len(termRunes) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func levenshtein(x, y string) int {
+	xr, yr := []rune(x), []rune(y)
+	prev := make([]int, len(yr)+1)
+	curr := make([]int, len(yr)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(xr); i++ {
+		curr[0] = i
+		for j := 1; j <= len(yr); j++ {
+			cost := 1
+			if xr[i-1] == yr[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(yr)]
+}
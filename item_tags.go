@@ -0,0 +1,248 @@
+// item_tags.go - Item Tags, Notes & Favorites for D2R Tracker
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ========== ITEM ANNOTATION API ==========
+
+func (a *App) SetItemNote(itemID string, expectedVersion int, note string) error {
+	note = strings.TrimSpace(note)
+	return a.modifyItems("note", []ItemRef{{ID: itemID, Version: expectedVersion}}, func(item *ItemEntry) bool {
+		item.Note = note
+		return true
+	}, "Changed item note")
+}
+
+func (a *App) SetItemFavorite(itemID string, expectedVersion int, favorite bool) error {
+	return a.modifyItems("favorite", []ItemRef{{ID: itemID, Version: expectedVersion}}, func(item *ItemEntry) bool {
+		item.Favorite = favorite
+		return true
+	}, map[bool]string{true: "Marked item as favorite", false: "Removed item from favorites"}[favorite])
+}
+
+// SetItemTags replaces the tags of an item; unknown tags are added to the profile's vocabulary
+func (a *App) SetItemTags(itemID string, expectedVersion int, tags []string) error {
+	cleaned := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag, err := validateTagName(tag)
+		if err != nil {
+			return err
+		}
+		cleaned = mergeTags(cleaned, []string{tag})
+	}
+
+	a.mu.Lock()
+	for i, tag := range cleaned {
+		if existing := a.findTag(tag); existing != "" {
+			cleaned[i] = existing
+		}
+	}
+	a.mu.Unlock()
+
+	return a.modifyItemsThen("tags", []ItemRef{{ID: itemID, Version: expectedVersion}}, func(item *ItemEntry) bool {
+		item.Tags = append([]string(nil), cleaned...)
+		return true
+	}, fmt.Sprintf("Set item tags: %s", strings.Join(cleaned, ", ")), func() {
+		for _, tag := range cleaned {
+			a.addTagToVocabulary(tag)
+		}
+	})
+}
+
+// ========== TAG VOCABULARY API ==========
+
+// GetTags returns the profile's tag vocabulary plus every tag still used by an item
+func (a *App) GetTags() []string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	tags := append([]string(nil), a.tagVocabulary...)
+	for _, item := range a.itemHistory {
+		tags = mergeTags(tags, item.Tags)
+	}
+	sort.Slice(tags, func(i, j int) bool { return strings.ToLower(tags[i]) < strings.ToLower(tags[j]) })
+	return tags
+}
+
+func (a *App) CreateTag(name string) error {
+	name, err := validateTagName(name)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	for _, tag := range a.tagVocabulary {
+		if strings.EqualFold(tag, name) {
+			a.mu.Unlock()
+			return fmt.Errorf("tag already exists: %s", tag)
+		}
+	}
+	a.tagVocabulary = append(a.tagVocabulary, name)
+	a.mu.Unlock()
+
+	fmt.Printf("🏷️ Created tag: %s\n", name)
+	a.saveAfterItemEdit()
+	return nil
+}
+
+// RenameTag renames a tag in the vocabulary and on every item (undoable for the items).
+// Renaming to an existing tag merges both tags.
+func (a *App) RenameTag(oldName, newName string) error {
+	oldName = strings.TrimSpace(oldName)
+	newName, err := validateTagName(newName)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	if a.findTag(oldName) == "" {
+		a.mu.Unlock()
+		return fmt.Errorf("tag not found: %s", oldName)
+	}
+	if existing := a.findTag(newName); existing != "" && !strings.EqualFold(existing, oldName) {
+		newName = existing // Merge into the existing tag
+	}
+	renameInVocabulary := func() {
+		vocabulary := make([]string, 0, len(a.tagVocabulary))
+		for _, tag := range a.tagVocabulary {
+			if strings.EqualFold(tag, oldName) {
+				tag = newName
+			}
+			vocabulary = mergeTags(vocabulary, []string{tag})
+		}
+		a.tagVocabulary = vocabulary
+	}
+	refs := a.getTaggedItemRefs(oldName)
+	if len(refs) == 0 {
+		renameInVocabulary()
+		a.mu.Unlock()
+		fmt.Printf("🏷️ Renamed tag: %s -> %s (no items)\n", oldName, newName)
+		a.saveAfterItemEdit()
+		return nil
+	}
+	a.mu.Unlock()
+
+	return a.modifyItemsThen("rename_tag", refs, func(item *ItemEntry) bool {
+		renamed := make([]string, 0, len(item.Tags))
+		for _, tag := range item.Tags {
+			if strings.EqualFold(tag, oldName) {
+				tag = newName
+			}
+			renamed = mergeTags(renamed, []string{tag})
+		}
+		item.Tags = renamed
+		return true
+	}, fmt.Sprintf("Renamed tag '%s' to '%s' on %d item(s)", oldName, newName, len(refs)), renameInVocabulary)
+}
+
+// DeleteTag removes a tag from the vocabulary and from every item (undoable for the items)
+func (a *App) DeleteTag(name string) error {
+	a.mu.Lock()
+	deleteFromVocabulary := func() {
+		vocabulary := make([]string, 0, len(a.tagVocabulary))
+		for _, tag := range a.tagVocabulary {
+			if !strings.EqualFold(tag, name) {
+				vocabulary = append(vocabulary, tag)
+			}
+		}
+		a.tagVocabulary = vocabulary
+	}
+	refs := a.getTaggedItemRefs(name)
+	if len(refs) == 0 {
+		deleteFromVocabulary()
+		a.mu.Unlock()
+		fmt.Printf("🏷️ Deleted tag: %s (no items)\n", name)
+		a.saveAfterItemEdit()
+		return nil
+	}
+	a.mu.Unlock()
+
+	return a.modifyItemsThen("delete_tag", refs, func(item *ItemEntry) bool {
+		remaining := make([]string, 0, len(item.Tags))
+		for _, tag := range item.Tags {
+			if !strings.EqualFold(tag, name) {
+				remaining = append(remaining, tag)
+			}
+		}
+		item.Tags = remaining
+		return true
+	}, fmt.Sprintf("Removed tag '%s' from %d item(s)", name, len(refs)), deleteFromVocabulary)
+}
+
+// ========== ITEM LIST FILTERS ==========
+
+// SetItemTagFilter restricts the item list to items with this tag; "" shows all items
+func (a *App) SetItemTagFilter(tag string) string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.itemTagFilter = strings.TrimSpace(tag)
+	fmt.Printf("📋 Item tag filter changed: '%s'\n", a.itemTagFilter)
+	return a.itemTagFilter
+}
+
+func (a *App) SetShowFavoritesOnly(favoritesOnly bool) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.showFavoritesOnly = favoritesOnly
+	fmt.Printf("📋 Favorites only: %t\n", favoritesOnly)
+	return a.showFavoritesOnly
+}
+
+// ========== TAG HELPERS ==========
+
+func validateTagName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("tag name cannot be empty")
+	}
+	if strings.ContainsAny(name, ";,") {
+		return "", fmt.Errorf("tag name cannot contain ';' or ','")
+	}
+	return name, nil
+}
+
+// addTagToVocabulary returns the existing spelling of a tag or adds it. Must be called with a.mu held.
+func (a *App) addTagToVocabulary(name string) string {
+	for _, tag := range a.tagVocabulary {
+		if strings.EqualFold(tag, name) {
+			return tag
+		}
+	}
+	a.tagVocabulary = append(a.tagVocabulary, name)
+	return name
+}
+
+// findTag returns the existing spelling of a tag from the vocabulary or the items ("" if unknown).
+// Must be called with a.mu held.
+func (a *App) findTag(name string) string {
+	for _, tag := range a.tagVocabulary {
+		if strings.EqualFold(tag, name) {
+			return tag
+		}
+	}
+	for _, item := range a.itemHistory {
+		for _, tag := range item.Tags {
+			if strings.EqualFold(tag, name) {
+				return tag
+			}
+		}
+	}
+	return ""
+}
+
+// getTaggedItemRefs returns refs to all items carrying a tag. Must be called with a.mu held.
+func (a *App) getTaggedItemRefs(name string) []ItemRef {
+	var refs []ItemRef
+	for _, item := range a.itemHistory {
+		if itemHasAllTags(item, []string{name}) {
+			refs = append(refs, ItemRef{ID: item.ID, Version: item.Version})
+		}
+	}
+	return refs
+}
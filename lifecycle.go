@@ -0,0 +1,306 @@
+// lifecycle.go - Item Lifecycle Tracking after Pickup for D2R Tracker
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+)
+
+// ========== LIFECYCLE STRUCTURES ==========
+
+// ItemEvent is one status change of a picked-up item.
+// Statuses: inventory, cube, equipped, stashed, dropped, sold, cubed (used in a cube recipe),
+// socketed and gone (vanished without a known reason, e.g. used or drunk).
+type ItemEvent struct {
+	Time   time.Time `json:"time"`
+	Status string    `json:"status"`
+	Area   string    `json:"area,omitempty"`
+}
+
+type KeeperStats struct {
+	TrackedItems int            `json:"tracked_items"` // Items with a known status
+	ByStatus     map[string]int `json:"by_status"`
+	Kept         int            `json:"kept"`        // Stashed, equipped, socketed or used in the cube
+	Discarded    int            `json:"discarded"`   // Sold or dropped
+	KeeperRate   float64        `json:"keeper_rate"` // Kept / (Kept + Discarded) in percent
+	ByQuality    []KeeperRate   `json:"by_quality"`
+}
+
+type KeeperRate struct {
+	Quality    string  `json:"quality"`
+	Kept       int     `json:"kept"`
+	Discarded  int     `json:"discarded"`
+	KeeperRate float64 `json:"keeper_rate"`
+}
+
+// trackedItem follows one ItemEntry by its unit ID during the current game
+type trackedItem struct {
+	entryID string
+	missing int // Consecutive ticks the unit was not found
+}
+
+// ========== LIFECYCLE TRACKING ==========
+
+func (a *App) updateItemLifecycle() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	gameData := a.lastGameData
+	if a.wasInMenu || gameData.PlayerUnit.Area == 0 || len(gameData.Inventory.AllItems) == 0 {
+		return
+	}
+
+	// Menus seen on this or the previous tick decide why an item vanished
+	shopOpen := gameData.OpenMenus.NPCShop || a.lifecycleShopOpen
+	cubeOpen := gameData.OpenMenus.Cube || a.lifecycleCubeOpen
+	a.lifecycleShopOpen = gameData.OpenMenus.NPCShop
+	a.lifecycleCubeOpen = gameData.OpenMenus.Cube
+
+	units := make(map[data.UnitID]data.Item, len(gameData.Inventory.AllItems))
+	socketed := make(map[data.UnitID]bool)
+	for _, itm := range gameData.Inventory.AllItems {
+		units[itm.UnitID] = itm
+		for _, socketItem := range itm.Sockets {
+			socketed[socketItem.UnitID] = true
+		}
+	}
+
+	if !a.lifecycleAttached {
+		a.attachCarriedItems(units)
+		a.lifecycleAttached = true
+	}
+
+	entryIndex := make(map[string]int, len(a.itemHistory))
+	for i, entry := range a.itemHistory {
+		entryIndex[entry.ID] = i
+	}
+
+	for unitID, tracked := range a.trackedItems {
+		idx, found := entryIndex[tracked.entryID]
+		if !found {
+			delete(a.trackedItems, unitID) // Item was deleted
+			continue
+		}
+		entry := &a.itemHistory[idx]
+
+		itm, present := units[unitID]
+		if present {
+			tracked.missing = 0
+			entry.TrackKey = itemTrackKey(itm)
+			status := itemStatusFromLocation(itm)
+			if socketed[unitID] {
+				status = "socketed"
+			}
+			if status != "" {
+				a.setItemStatus(entry, status)
+			}
+			if isFinalItemStatus(entry.Status) {
+				delete(a.trackedItems, unitID)
+			}
+			continue
+		}
+
+		// Ground items disappear from memory once the player walks away
+		if entry.Status == "dropped" {
+			delete(a.trackedItems, unitID)
+			continue
+		}
+
+		// Require two missing ticks so a partial memory read does not end the item's life
+		tracked.missing++
+		if tracked.missing < 2 {
+			continue
+		}
+
+		switch {
+		case socketed[unitID]:
+			a.setItemStatus(entry, "socketed")
+		case shopOpen:
+			a.setItemStatus(entry, "sold")
+		case cubeOpen && entry.Status == "cube":
+			a.setItemStatus(entry, "cubed")
+		default:
+			a.setItemStatus(entry, "gone")
+		}
+		delete(a.trackedItems, unitID)
+	}
+}
+
+// trackPickedUpItem starts following a new ItemEntry. Must be called with a.mu held.
+func (a *App) trackPickedUpItem(entry *ItemEntry, itm data.Item) {
+	entry.TrackKey = itemTrackKey(itm)
+	status := itemStatusFromLocation(itm)
+	if status == "" {
+		status = "inventory"
+	}
+	a.setItemStatus(entry, status)
+
+	if a.trackedItems == nil {
+		a.trackedItems = make(map[data.UnitID]*trackedItem)
+	}
+	a.trackedItems[itm.UnitID] = &trackedItem{entryID: entry.ID}
+}
+
+// resetItemLifecycle forgets unit IDs, which are only valid within one game. Must be called with a.mu held.
+func (a *App) resetItemLifecycle() {
+	a.trackedItems = make(map[data.UnitID]*trackedItem)
+	a.lifecycleAttached = false
+	a.lifecycleShopOpen = false
+	a.lifecycleCubeOpen = false
+}
+
+// attachCarriedItems finds items from earlier games again by their track key.
+// Must be called with a.mu held.
+func (a *App) attachCarriedItems(units map[data.UnitID]data.Item) {
+	claimed := make(map[data.UnitID]bool, len(a.trackedItems))
+	trackedEntries := make(map[string]bool, len(a.trackedItems))
+	for unitID, tracked := range a.trackedItems {
+		claimed[unitID] = true
+		trackedEntries[tracked.entryID] = true
+	}
+
+	candidates := make(map[string][]data.Item)
+	for _, itm := range units {
+		if !claimed[itm.UnitID] {
+			key := itemTrackKey(itm)
+			candidates[key] = append(candidates[key], itm)
+		}
+	}
+
+	// Prefer a unit in the same place as last seen, then any unit with the same identity
+	attached := 0
+	for _, samePlace := range []bool{true, false} {
+		for i := len(a.itemHistory) - 1; i >= 0; i-- {
+			entry := a.itemHistory[i]
+			if entry.TrackKey == "" || entry.Status == "" || entry.Status == "dropped" || isFinalItemStatus(entry.Status) {
+				continue
+			}
+			if trackedEntries[entry.ID] {
+				continue
+			}
+			for _, itm := range candidates[entry.TrackKey] {
+				if claimed[itm.UnitID] {
+					continue
+				}
+				if samePlace && itemStatusFromLocation(itm) != entry.Status {
+					continue
+				}
+				claimed[itm.UnitID] = true
+				trackedEntries[entry.ID] = true
+				a.trackedItems[itm.UnitID] = &trackedItem{entryID: entry.ID}
+				attached++
+				break
+			}
+		}
+	}
+
+	if attached > 0 {
+		fmt.Printf("🔗 Item lifecycle: found %d item(s) from earlier games\n", attached)
+	}
+}
+
+// setItemStatus records a status change. Must be called with a.mu held.
+func (a *App) setItemStatus(entry *ItemEntry, status string) {
+	if entry.Status == status {
+		return
+	}
+	entry.Status = status
+	entry.Lifecycle = append(entry.Lifecycle, ItemEvent{
+		Time:   time.Now(),
+		Status: status,
+		Area:   a.getCurrentAreaName(),
+	})
+	fmt.Printf("🔄 Item '%s' is now %s\n", entry.Name, status)
+}
+
+// ========== LIFECYCLE API ==========
+
+// GetKeeperStats shows which drops are actually kept
+func (a *App) GetKeeperStats() KeeperStats {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	stats := KeeperStats{ByStatus: make(map[string]int), ByQuality: []KeeperRate{}}
+	byQuality := make(map[string]*KeeperRate)
+	for _, entry := range a.itemHistory {
+		if entry.Status == "" {
+			continue
+		}
+		stats.TrackedItems++
+		stats.ByStatus[entry.Status]++
+
+		rate, found := byQuality[entry.Quality]
+		if !found {
+			rate = &KeeperRate{Quality: entry.Quality}
+			byQuality[entry.Quality] = rate
+		}
+		switch entry.Status {
+		case "stashed", "equipped", "socketed", "cubed":
+			stats.Kept++
+			rate.Kept++
+		case "sold", "dropped":
+			stats.Discarded++
+			rate.Discarded++
+		}
+	}
+
+	stats.KeeperRate = keeperRate(stats.Kept, stats.Discarded)
+	for _, rate := range byQuality {
+		rate.KeeperRate = keeperRate(rate.Kept, rate.Discarded)
+		stats.ByQuality = append(stats.ByQuality, *rate)
+	}
+	sort.Slice(stats.ByQuality, func(i, j int) bool {
+		return qualityRank(stats.ByQuality[i].Quality) < qualityRank(stats.ByQuality[j].Quality)
+	})
+	return stats
+}
+
+// ========== LIFECYCLE HELPERS ==========
+
+func itemStatusFromLocation(itm data.Item) string {
+	if itm.IsInSocket {
+		return "socketed"
+	}
+	switch itm.Location.LocationType {
+	case item.LocationInventory, item.LocationBelt, item.LocationCursor:
+		return "inventory"
+	case item.LocationCube:
+		return "cube"
+	case item.LocationStash, item.LocationSharedStash:
+		return "stashed"
+	case item.LocationEquipped, item.LocationMercenary:
+		return "equipped"
+	case item.LocationGround:
+		return "dropped"
+	case item.LocationVendor:
+		return "sold"
+	case item.LocationSocket:
+		return "socketed"
+	}
+	return ""
+}
+
+// isFinalItemStatus reports statuses after which the item cannot be followed anymore
+func isFinalItemStatus(status string) bool {
+	switch status {
+	case "sold", "cubed", "socketed", "gone":
+		return true
+	}
+	return false
+}
+
+// itemTrackKey identifies an item across games, where unit IDs change
+func itemTrackKey(itm data.Item) string {
+	return fmt.Sprintf("%s|%d|%s|%t", itm.Name, itm.Quality, itm.IdentifiedName, itm.Ethereal)
+}
+
+func keeperRate(kept, discarded int) float64 {
+	if kept+discarded == 0 {
+		return 0
+	}
+	return float64(kept) / float64(kept+discarded) * 100
+}
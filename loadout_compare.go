@@ -0,0 +1,412 @@
+// loadout_compare.go - Loadout A/B Comparison for D2R Tracker
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// Differences with a Holm-adjusted p-value below this are reported as significant
+	loadoutSignificanceLevel = 0.05
+	// Confidence level of the reported intervals
+	loadoutConfidence = 0.95
+)
+
+// ========== LOADOUT COMPARISON STRUCTURES ==========
+
+// LoadoutMetric is the mean of a per-run value with its 95% confidence interval
+type LoadoutMetric struct {
+	Mean   float64 `json:"mean"`
+	StdDev float64 `json:"std_dev"`
+	Low    float64 `json:"low"`  // Lower end of the interval, at least 0 (equal to Mean with fewer than 2 runs)
+	High   float64 `json:"high"` // Upper end of the interval
+}
+
+// LoadoutGroup collects the runs done with one loadout or run tag
+type LoadoutGroup struct {
+	Loadout        string        `json:"loadout"` // Loadout ID or user tag
+	Name           string        `json:"name"`    // Loadout name, or the tag itself
+	Runs           int           `json:"runs"`
+	Minutes        LoadoutMetric `json:"minutes"`
+	Uniques        LoadoutMetric `json:"uniques"`
+	Sets           LoadoutMetric `json:"sets"`
+	Runes          LoadoutMetric `json:"runes"`
+	XP             LoadoutMetric `json:"xp"`
+	UniquesPerHour float64       `json:"uniques_per_hour"` // Total uniques per hour of run time
+	samples        map[string][]float64
+}
+
+// SignificanceTest is a Welch's t-test of one per-run metric between two loadouts.
+// All metrics of a comparison are tested at once, so the p-values are Holm-adjusted.
+type SignificanceTest struct {
+	Metric         string  `json:"metric"`     // "minutes", "uniques", "sets", "runes" or "xp"
+	Difference     float64 `json:"difference"` // Mean of A minus mean of B
+	T              float64 `json:"t"`
+	DF             float64 `json:"df"`
+	PValue         float64 `json:"p_value"`          // Two-sided; 1 when the test cannot be run
+	AdjustedPValue float64 `json:"adjusted_p_value"` // Holm-adjusted over the metrics that could be tested
+	Significant    bool    `json:"significant"`      // AdjustedPValue below 0.05
+	Verdict        string  `json:"verdict"`
+	tested         bool
+}
+
+type LoadoutComparison struct {
+	A     LoadoutGroup       `json:"a"`
+	B     LoadoutGroup       `json:"b"`
+	Tests []SignificanceTest `json:"tests"`
+}
+
+var loadoutMetrics = []string{"minutes", "uniques", "sets", "runes", "xp"}
+
+// ========== RUN GROUPING ==========
+
+// runLoadoutKey is the user tag of a run, or the loadout worn longest
+func runLoadoutKey(record RunRecord) string {
+	if record.LoadoutTag != "" {
+		return record.LoadoutTag
+	}
+	return record.Loadout
+}
+
+// loadoutName returns the name of a loadout ID, or the key itself for tags. Must be called with a.mu held.
+func (a *App) loadoutName(key string) string {
+	for _, loadout := range a.equipmentLog.Loadouts {
+		if loadout.ID == key {
+			if loadout.Name != "" {
+				return loadout.Name
+			}
+			return loadout.ID
+		}
+	}
+	return key
+}
+
+// matchesLoadout reports whether a run key belongs to a query: a loadout ID, a loadout name or a tag.
+// Loadouts sharing a name are one group. Must be called with a.mu held.
+func (a *App) matchesLoadout(key string, query string) bool {
+	return key == query || strings.EqualFold(a.loadoutName(key), query)
+}
+
+// collectLoadoutGroup gathers the per-run samples of all runs matching a query. Must be called with a.mu held.
+func (a *App) collectLoadoutGroup(query string, drops map[int]map[string]int) LoadoutGroup {
+	group := LoadoutGroup{Loadout: query, Name: a.loadoutName(query), samples: make(map[string][]float64)}
+
+	var uniques int
+	var hours float64
+	for _, record := range a.runRecords {
+		key := runLoadoutKey(record)
+		if key == "" || !a.matchesLoadout(key, query) {
+			continue
+		}
+		duration := time.Duration(record.DurationMs) * time.Millisecond
+		group.Runs++
+		group.samples["minutes"] = append(group.samples["minutes"], duration.Minutes())
+		group.samples["uniques"] = append(group.samples["uniques"], float64(drops[record.Index]["uniques"]))
+		group.samples["sets"] = append(group.samples["sets"], float64(drops[record.Index]["sets"]))
+		group.samples["runes"] = append(group.samples["runes"], float64(drops[record.Index]["runes"]))
+		group.samples["xp"] = append(group.samples["xp"], float64(record.XP))
+		uniques += drops[record.Index]["uniques"]
+		hours += duration.Hours()
+	}
+
+	group.Minutes = summarizeSamples(group.samples["minutes"])
+	group.Uniques = summarizeSamples(group.samples["uniques"])
+	group.Sets = summarizeSamples(group.samples["sets"])
+	group.Runes = summarizeSamples(group.samples["runes"])
+	group.XP = summarizeSamples(group.samples["xp"])
+	if hours > 0 {
+		group.UniquesPerHour = float64(uniques) / hours
+	}
+	return group
+}
+
+// countRunDrops counts uniques, sets and runes per run index. Must be called with a.mu held.
+func (a *App) countRunDrops() map[int]map[string]int {
+	drops := make(map[int]map[string]int)
+	for _, entry := range a.itemHistory {
+		kind := ""
+		switch {
+		case entry.Quality == "Unique":
+			kind = "uniques"
+		case entry.Quality == "Set":
+			kind = "sets"
+		case strings.HasSuffix(entry.OriginalName, " Rune"):
+			kind = "runes"
+		default:
+			continue
+		}
+		if drops[entry.RunIndex] == nil {
+			drops[entry.RunIndex] = make(map[string]int)
+		}
+		drops[entry.RunIndex][kind]++
+	}
+	return drops
+}
+
+// ========== STATISTICS ==========
+
+func summarizeSamples(samples []float64) LoadoutMetric {
+	n := float64(len(samples))
+	if n == 0 {
+		return LoadoutMetric{}
+	}
+	mean, variance := meanVariance(samples)
+	metric := LoadoutMetric{Mean: mean, Low: mean, High: mean}
+	if len(samples) < 2 {
+		return metric
+	}
+	metric.StdDev = math.Sqrt(variance)
+	margin := studentTQuantile(1-(1-loadoutConfidence)/2, n-1) * metric.StdDev / math.Sqrt(n)
+	metric.Low = math.Max(0, mean-margin) // Run times, drops and XP are never negative
+	metric.High = mean + margin
+	return metric
+}
+
+// meanVariance returns the mean and the sample variance
+func meanVariance(samples []float64) (float64, float64) {
+	var sum float64
+	for _, sample := range samples {
+		sum += sample
+	}
+	mean := sum / float64(len(samples))
+	if len(samples) < 2 {
+		return mean, 0
+	}
+	var squares float64
+	for _, sample := range samples {
+		squares += (sample - mean) * (sample - mean)
+	}
+	return mean, squares / float64(len(samples)-1)
+}
+
+// welchTest compares the means of two samples without assuming equal variances
+func welchTest(metric string, a, b []float64) SignificanceTest {
+	test := SignificanceTest{Metric: metric, PValue: 1, AdjustedPValue: 1}
+	if len(a) < 2 || len(b) < 2 {
+		test.Verdict = "needs at least 2 runs per loadout"
+		return test
+	}
+
+	meanA, varA := meanVariance(a)
+	meanB, varB := meanVariance(b)
+	nA, nB := float64(len(a)), float64(len(b))
+	test.Difference = meanA - meanB
+
+	seA, seB := varA/nA, varB/nB
+	if seA+seB == 0 {
+		// Every run of both loadouts has the same value; the t statistic is undefined
+		test.Verdict = "not testable (no variation between runs)"
+		return test
+	}
+	test.T = test.Difference / math.Sqrt(seA+seB)
+	test.DF = (seA + seB) * (seA + seB) / (seA*seA/(nA-1) + seB*seB/(nB-1))
+	test.PValue = 2 * (1 - studentTCDF(math.Abs(test.T), test.DF))
+	test.tested = true
+	return test
+}
+
+// holmAdjust applies the Holm-Bonferroni correction to the tests that could be run
+// and sets Significant and Verdict from the adjusted p-values
+func holmAdjust(tests []SignificanceTest) {
+	order := make([]int, 0, len(tests))
+	for i := range tests {
+		if tests[i].tested {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool { return tests[order[i]].PValue < tests[order[j]].PValue })
+
+	adjusted := 0.0
+	for rank, idx := range order {
+		// Keep the adjusted p-values in the same order as the raw ones
+		adjusted = math.Max(adjusted, math.Min(1, float64(len(order)-rank)*tests[idx].PValue))
+		test := &tests[idx]
+		test.AdjustedPValue = adjusted
+		test.Significant = adjusted < loadoutSignificanceLevel
+
+		switch {
+		case !test.Significant:
+			test.Verdict = fmt.Sprintf("no significant difference (adjusted p = %.3f)", adjusted)
+		case test.Difference > 0:
+			test.Verdict = fmt.Sprintf("A is higher (adjusted p = %.3f)", adjusted)
+		default:
+			test.Verdict = fmt.Sprintf("B is higher (adjusted p = %.3f)", adjusted)
+		}
+	}
+}
+
+// studentTCDF is the cumulative distribution function of Student's t-distribution
+func studentTCDF(t float64, df float64) float64 {
+	tail := 0.5 * regularizedIncompleteBeta(df/(df+t*t), df/2, 0.5)
+	if t >= 0 {
+		return 1 - tail
+	}
+	return tail
+}
+
+// studentTQuantile inverts studentTCDF by bisection
+func studentTQuantile(p float64, df float64) float64 {
+	low, high := -1000.0, 1000.0
+	for i := 0; i < 100; i++ {
+		mid := (low + high) / 2
+		if studentTCDF(mid, df) < p {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return (low + high) / 2
+}
+
+// regularizedIncompleteBeta computes I_x(a, b) with a continued fraction (Numerical Recipes, betai)
+func regularizedIncompleteBeta(x, a, b float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+	lgA, _ := math.Lgamma(a)
+	lgB, _ := math.Lgamma(b)
+	lgAB, _ := math.Lgamma(a + b)
+	front := math.Exp(lgAB - lgA - lgB + a*math.Log(x) + b*math.Log(1-x))
+	if x < (a+1)/(a+b+2) {
+		return front * betaContinuedFraction(x, a, b) / a
+	}
+	return 1 - front*betaContinuedFraction(1-x, b, a)/b
+}
+
+func betaContinuedFraction(x, a, b float64) float64 {
+	const tiny = 1e-300
+	c, d := 1.0, 1-(a+b)*x/(a+1)
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	result := d
+	for m := 1.0; m <= 200; m++ {
+		// Even step
+		numerator := m * (b - m) * x / ((a + 2*m - 1) * (a + 2*m))
+		d = 1 + numerator*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + numerator/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		result *= d * c
+		// Odd step
+		numerator = -(a + m) * (a + b + m) * x / ((a + 2*m) * (a + 2*m + 1))
+		d = 1 + numerator*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + numerator/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		result *= delta
+		if math.Abs(delta-1) < 1e-12 {
+			break
+		}
+	}
+	return result
+}
+
+// ========== LOADOUT COMPARISON API ==========
+
+// GetLoadoutReport returns every loadout and run tag with runs, with 95% confidence intervals, most runs first.
+// Loadouts sharing a name are reported together.
+func (a *App) GetLoadoutReport() []LoadoutGroup {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	drops := a.countRunDrops()
+	seen := make(map[string]bool)
+	groups := make([]LoadoutGroup, 0)
+	for _, record := range a.runRecords {
+		key := runLoadoutKey(record)
+		if key == "" {
+			continue
+		}
+		query := key
+		if name := a.loadoutName(key); name != key {
+			query = name
+		}
+		if seen[strings.ToLower(query)] {
+			continue
+		}
+		seen[strings.ToLower(query)] = true
+		groups = append(groups, a.collectLoadoutGroup(query, drops))
+	}
+	sort.SliceStable(groups, func(i, j int) bool { return groups[i].Runs > groups[j].Runs })
+	return groups
+}
+
+// CompareLoadouts tests whether two loadouts differ in run time, drops and XP per run.
+// A loadout can be given by ID, by name or by run tag.
+func (a *App) CompareLoadouts(loadoutA string, loadoutB string) (LoadoutComparison, error) {
+	loadoutA = strings.TrimSpace(loadoutA)
+	loadoutB = strings.TrimSpace(loadoutB)
+	if loadoutA == "" || loadoutB == "" {
+		return LoadoutComparison{}, fmt.Errorf("two loadouts are needed for a comparison")
+	}
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	drops := a.countRunDrops()
+	comparison := LoadoutComparison{
+		A:     a.collectLoadoutGroup(loadoutA, drops),
+		B:     a.collectLoadoutGroup(loadoutB, drops),
+		Tests: make([]SignificanceTest, 0, len(loadoutMetrics)),
+	}
+	if comparison.A.Runs == 0 {
+		return LoadoutComparison{}, fmt.Errorf("no runs with loadout %s", loadoutA)
+	}
+	if comparison.B.Runs == 0 {
+		return LoadoutComparison{}, fmt.Errorf("no runs with loadout %s", loadoutB)
+	}
+	for _, metric := range loadoutMetrics {
+		comparison.Tests = append(comparison.Tests, welchTest(metric, comparison.A.samples[metric], comparison.B.samples[metric]))
+	}
+	holmAdjust(comparison.Tests)
+	return comparison, nil
+}
+
+// SetRunLoadout tags a run with a loadout (ID, name or free text like "300 MF").
+// The current or upcoming run is tagged when it ends; an empty tag goes back to the equipped gear.
+func (a *App) SetRunLoadout(runIndex int, loadout string) error {
+	loadout = strings.TrimSpace(loadout)
+
+	a.mu.Lock()
+	if runIndex == a.currentRun {
+		a.runLoadoutTag = loadout
+		a.mu.Unlock()
+		fmt.Printf("🛡️ Run #%d will be tagged as loadout %q\n", runIndex, loadout)
+		return nil
+	}
+	found := false
+	for i := range a.runRecords {
+		if a.runRecords[i].Index == runIndex {
+			a.runRecords[i].LoadoutTag = loadout
+			found = true
+			break
+		}
+	}
+	a.mu.Unlock()
+
+	if !found {
+		return fmt.Errorf("run not found: %d", runIndex)
+	}
+	a.SaveCurrentProfile()
+	return nil
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestStudentTQuantile(t *testing.T) {
+	tests := []struct {
+		p    float64
+		df   float64
+		want float64
+	}{
+		{0.975, 1, 12.706},
+		{0.975, 2, 4.303},
+		{0.975, 10, 2.228},
+		{0.975, 30, 2.042},
+		{0.95, 5, 2.015},
+		{0.5, 7, 0},
+	}
+	for _, tt := range tests {
+		if got := studentTQuantile(tt.p, tt.df); math.Abs(got-tt.want) > 0.001 {
+			t.Errorf("studentTQuantile(%v, %v) = %.4f, want %.3f", tt.p, tt.df, got, tt.want)
+		}
+	}
+}
+
+func TestStudentTCDF(t *testing.T) {
+	tests := []struct {
+		t    float64
+		df   float64
+		want float64
+	}{
+		{0, 3, 0.5},
+		{12.706, 1, 0.975},
+		{-2.228, 10, 0.025},
+		{1.96, 1e6, 0.975},
+	}
+	for _, tt := range tests {
+		if got := studentTCDF(tt.t, tt.df); math.Abs(got-tt.want) > 0.0005 {
+			t.Errorf("studentTCDF(%v, %v) = %.4f, want %.3f", tt.t, tt.df, got, tt.want)
+		}
+	}
+}
+
+func TestWelchTestWithoutVariation(t *testing.T) {
+	test := welchTest("uniques", []float64{2, 2, 2}, []float64{1, 1, 1})
+	if test.PValue != 1 || test.tested {
+		t.Errorf("got p = %v, tested = %v; want p = 1 and not tested", test.PValue, test.tested)
+	}
+}
+
+func TestHolmAdjust(t *testing.T) {
+	tests := []SignificanceTest{
+		{Metric: "a", PValue: 0.01, tested: true},
+		{Metric: "b", PValue: 0.04, tested: true},
+		{Metric: "c", PValue: 0.03, tested: true},
+		{Metric: "d", PValue: 1},
+	}
+	holmAdjust(tests)
+
+	want := []float64{0.03, 0.06, 0.06}
+	for i, test := range tests[:3] {
+		if math.Abs(test.AdjustedPValue-want[i]) > 1e-9 {
+			t.Errorf("%s: adjusted p = %v, want %v", test.Metric, test.AdjustedPValue, want[i])
+		}
+	}
+	if !tests[0].Significant || tests[1].Significant || tests[2].Significant {
+		t.Errorf("only metric a should be significant: %+v", tests)
+	}
+}
@@ -6,10 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"os"
 	"path/filepath"
 	"sort"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -34,6 +34,8 @@ type CorpseInfo struct {
 }
 
 type ItemEntry struct {
+	ID           string    `json:"id"`                  // Stable unique ID, assigned at pickup
+	Version      int       `json:"version"`             // Incremented on every change (optimistic concurrency)
 	Name         string    `json:"name"`
 	OriginalName string    `json:"original_name"` // Store original name
 	Quality      string    `json:"quality"`
@@ -44,8 +46,19 @@ type ItemEntry struct {
 	IsEthereal   bool   `json:"is_ethereal,omitempty"`   // Ethereal flag
 	IsIdentified bool   `json:"is_identified,omitempty"` // Identified flag
 	ItemLevel    int    `json:"item_level,omitempty"`    // Item level
-	// ========== KORREKTUR: Array Index für Frontend ==========
-	ArrayIndex   int    `json:"array_index"`             // Echter Array-Index im itemHistory
+	// ========== ITEM CONTEXT ==========
+	Area         string   `json:"area,omitempty"`     // Area where the item was picked up
+	RunType      string   `json:"run_type,omitempty"` // Run type, set when the run ends
+	Tags         []string `json:"tags,omitempty"`     // User-defined tags
+	Note         string   `json:"note,omitempty"`     // Free-text note
+	Favorite     bool     `json:"favorite,omitempty"` // Favorite star
+	Value        float64  `json:"value,omitempty"`    // Estimated value from the price table
+	// ========== ITEM LIFECYCLE ==========
+	Status    string      `json:"status,omitempty"`    // Where the item is now (stashed, sold, ...)
+	Lifecycle []ItemEvent `json:"lifecycle,omitempty"` // Status changes since pickup
+	TrackKey  string      `json:"track_key,omitempty"` // Identity used to find the item again in later games
+	// ========== CHARMS & JEWELS ==========
+	Charm *CharmInfo `json:"charm,omitempty"` // Charm/jewel class and rolls
 }
 
 // ========== XP TRACKING STRUCTURES ==========
@@ -62,6 +75,13 @@ type XPTracking struct {
 	// ========== VERBESSERTE FELDER FÜR RUNS BERECHNUNG ==========
 	EstimatedRunsToNext int     `json:"estimated_runs_to_next"`  // Schätzung basierend auf aktuellem Run
 	RunsCalculationMethod string `json:"runs_calculation_method"` // Welche Methode verwendet wurde
+	// ========== EXPERIENCE MODEL ==========
+	LevelProgress float64 `json:"level_progress"` // Percent of the current level done
+	XPToLevel99   int64   `json:"xp_to_level_99"` // Experience still missing for level 99
+	// ========== DEATHS ==========
+	SessionXPLost int64   `json:"session_xp_lost"` // Experience lost to deaths this session
+	NetXPPerHour  float64 `json:"net_xp_per_hour"` // (Gained - lost) per hour
+	SessionDeaths int     `json:"session_deaths"`
 }
 
 type PersistentData struct {
@@ -73,6 +93,31 @@ type PersistentData struct {
 	// ========== XP TRACKING DATA ==========
 	XPTracking     XPTracking `json:"xp_tracking"`
 	XPRunHistory   []int64    `json:"xp_run_history"`   // XP gained per run
+	// ========== RUN RECORDS ==========
+	Runs           []RunRecord `json:"runs"`
+	// ========== ITEM AUDIT LOG ==========
+	AuditLog       []AuditEntry `json:"audit_log"`
+	// ========== TAG VOCABULARY ==========
+	Tags           []string `json:"tags"`
+	// ========== GAMBLING ==========
+	Gambles        []GambleRecord `json:"gambles"`
+	// ========== VENDOR SHOPPING ==========
+	ShoppingRules   []ShoppingRule  `json:"shopping_rules"`
+	ShoppingMatches []ShoppingMatch `json:"shopping_matches"`
+	// ========== HORADRIC CUBE ==========
+	Transmutes []CubeTransmute `json:"transmutes"`
+	// ========== RUNEWORDS ==========
+	Runewords []RunewordRecord `json:"runewords"`
+	// ========== DEATHS ==========
+	Deaths []DeathRecord `json:"deaths"`
+	// ========== XP RATES ==========
+	XPRateWindows XPRateSettings `json:"xp_rate_windows"`
+	// ========== XP HISTORY ==========
+	XPHistory XPHistory `json:"xp_history"`
+	// ========== EQUIPMENT ==========
+	Equipment EquipmentLog `json:"equipment"`
+	// ========== MERCENARY ==========
+	Mercenary MercLog `json:"mercenary"`
 }
 
 // ========== NEUE STRUKTUR FÜR ITEM PAGINATION ==========
@@ -83,6 +128,9 @@ type ItemsResponse struct {
 	ItemsPerPage   int         `json:"items_per_page"`  // Items pro Seite
 	TotalPages     int         `json:"total_pages"`     // Gesamtanzahl Seiten
 	ShowAll        bool        `json:"show_all"`        // Ob alle Items angezeigt werden
+	QualityFilter  string      `json:"quality_filter"`  // Aktiver Qualitäts-Filter ("" = alle)
+	TagFilter      string      `json:"tag_filter"`      // Aktiver Tag-Filter ("" = alle)
+	FavoritesOnly  bool        `json:"favorites_only"`  // Nur Favoriten
 }
 
 // ========== ITEM DATA STRUCTURES ==========
@@ -118,12 +166,18 @@ type GameStats struct {
 	FiltersEnabled bool        `json:"filtersEnabled"`
 	// ========== XP TRACKING & CHARACTER INFO ==========
 	XPTracking       XPTracking `json:"xpTracking"`
+	XPRates          XPRates    `json:"xpRates"` // Rolling XP rates and level forecast
 	PlayerLevel      int        `json:"playerLevel"`
 	PlayerClass      string     `json:"playerClass"`
 	CurrentArea      string     `json:"currentArea"`
 	SessionStartTime time.Time  `json:"sessionStartTime"`
+	CurrentRunType   string     `json:"currentRunType"`
 	// ========== NEUE ITEM PAGINATION ==========
 	ItemsData        ItemsResponse `json:"itemsData"`    // Neue strukturierte Item-Daten
+	QualityCounts    map[string]int `json:"qualityCounts"` // Items pro Qualität
+	ValueStats       ValueStats     `json:"valueStats"`    // Estimated value per run, hour and run type
+	Gold             GoldStats      `json:"gold"`          // Gold income and expenses
+	Merc             MercStatus     `json:"merc"`          // Mercenary level, deaths and resurrection gold
 }
 
 // ========== APP STRUCT (ERWEITERT) ==========
@@ -153,24 +207,117 @@ type App struct {
 	trackerInitialized bool
 
 	// ========== RACE CONDITION PROTECTION ==========
-	editMutex sync.Mutex // Separate mutex for item editing
 	saveMutex sync.Mutex // Separate mutex for save operations
 
 	// ========== XP TRACKING ==========
 	xpTracking         XPTracking
-	xpRunHistory       []int64       // XP gained per run (last 20 runs)
+	xpCharacter        string        // Character whose experience is in xpTracking.CurrentXP
+	xpRunHistory       []int64       // XP gained per run, rolling window of the last 20 runs for run averages; xpHistory is the full log
 	sessionStartTime   time.Time     // When current session started
 	lastGameData       data.Data     // Store last game data for comparisons
 
+	// ========== RUN RECORDS ==========
+	runRecords         []RunRecord              // Completed runs with run type
+	runAreaTime        map[string]time.Duration // Time spent per area in the active run
+	runAreaXP          map[string]int64         // Experience gained per area in the active run
+	lastAreaTick       time.Time                // Last run area tracking update
+	// Stash snapshots (per character, stored outside the profile)
+	stashData          StashData      // Stash of the current character
+	stashSignature     map[string]int // Raw fingerprint of the last stash read
+	stashEmptyReads    int            // Empty stash reads in a row while the stash is unconfirmed
+	stashWasOpen       bool           // Stash menu was open on the last tick
+	lastStashSnapshot  time.Time      // Last stash snapshot attempt
+	// Item lifecycle (unit IDs are only valid within one game)
+	trackedItems       map[data.UnitID]*trackedItem
+	lifecycleAttached  bool // Items from earlier games were matched in this game
+	lifecycleShopOpen  bool // Vendor menu was open on the last tick
+	lifecycleCubeOpen  bool // Cube was open on the last tick
+	// Gold tracking
+	lastGold           int                   // Inventory + stash gold on the last tick
+	goldSampled        bool                  // lastGold is valid for the current game
+	goldShopOpen       bool                  // Vendor menu was open on the last tick
+	goldHadMerc        bool                  // Merc was alive on the last tick
+	goldCarriedItems   map[data.UnitID]bool  // Items owned on the last tick
+	lastShopPurchase   time.Time             // Last time a bought item appeared
+	pendingShopLoss    int                   // Shop gold loss waiting for its item (0 = none)
+	pendingShopSince   time.Time             // When the pending shop loss started
+	runGold            GoldBreakdown         // Gold changes in the active run
+	sessionGold        GoldBreakdown         // Gold changes since the app started
+	goldActiveTime     time.Duration         // Session time in runs, for gold per hour
+	lastGoldTick       time.Time             // Last gold sample
+	// Gambling
+	gambleHistory      []GambleRecord // Gambled items (not part of itemHistory)
+	lastVendorItems    []data.Item    // Vendor listing on the last tick
+	lastGamble         time.Time      // Last time a gambled item appeared
+	// Vendor shopping scanner
+	shoppingRules      []ShoppingRule       // User rules for vendor items
+	shoppingMatches    []ShoppingMatch      // Logged rule matches
+	shoppingSeen       map[data.UnitID]bool // Vendor items already checked in this game
+	// Horadric Cube transmutes
+	transmuteHistory   []CubeTransmute           // Logged transmutes
+	cubeItems          map[data.UnitID]data.Item // Cube contents on the last tick (nil = not sampled)
+	cubeKnownUnits     map[data.UnitID]bool      // All item units on the last tick
+	cubeWasOpen        bool                      // Cube window state on the last tick
+	pendingTransmute   *pendingTransmute         // Transmute waiting for its outputs
+	// Runewords
+	runewordHistory    []RunewordRecord                   // Created runewords
+	runewordCandidates map[data.UnitID]*runewordCandidate // Socketed bases without a runeword in this game
+	// Deaths
+	deathHistory       []DeathRecord // Logged deaths
+	deathSampled       bool          // Player state was read in this game
+	deathWasDead       bool          // Player was dead on the last tick
+	deathHadCorpse     bool          // Player had a corpse on the last tick
+	lastDeath          time.Time     // Last detected death
+	pendingDeathXP     int64         // XP lost before the death was detected
+	pendingDeathGold   int           // Gold lost before the death was detected
+	pendingDeathLossAt time.Time     // Last pending loss
+	// Rolling XP rates
+	xpRateSettings     XPRateSettings // Rate windows of this profile
+	xpBuckets          []xpBucket     // XP and play time per minute of the session
+	xpActiveTime       time.Duration  // Time in game this session
+	lastXPTick         time.Time      // Last XP sample
+	// XP history
+	xpHistory          XPHistory // Run XP and level-ups of this profile
+	xpRunCounted       bool      // Active run was counted for the current level
+	// Character-aware profiles
+	characterInfo         CharacterInfo          // Character of the current game
+	characterChecked      bool                   // Character was read in this game
+	characterProfiles     CharacterProfileConfig // Character -> profile mapping
+	characterProfilesPath string                 // Where the mapping is saved
+	runSnapshot           *CharacterSnapshot     // Character sheet of the active run
+	// Equipment
+	equipmentLog          EquipmentLog             // Equipped items, swaps and loadouts of this profile
+	pendingEquipment      map[string]EquippedItem  // Changed equipment waiting to settle
+	pendingEquipmentSince time.Time                // When the pending equipment was first seen
+	lastEquipmentTick     time.Time                // Last equipment tracking update
+	runLoadoutTime        map[string]time.Duration // Time spent per loadout in the active run
+	runLoadoutTag         string                   // User loadout tag for the current run
+	// Mercenary
+	mercLog               MercLog   // Merc deaths, resurrections and levels of this profile
+	mercSampled           bool      // Merc state was read in this game
+	mercWasAlive          bool      // Merc was alive on the last tick
+	mercWasDying          bool      // Merc was in its death animation on the last tick
+	lastMercDeath         time.Time // Last detected merc death
+
+	// ========== ITEM AUDIT LOG ==========
+	auditLog           []AuditEntry // Undoable item changes
+	nextAuditID        int          // Last assigned audit entry ID
+	tagVocabulary      []string     // Tags managed for this profile
+
 	// ========== ITEM DISPLAY SETTINGS ==========
 	itemsPerPage       int           // Configurable items per page
 	showAllItems       bool          // Whether to show all items or paginate
+	itemQualityFilter  string        // Only show items of this quality ("" = all)
+	itemTagFilter      string        // Only show items with this tag ("" = all)
+	showFavoritesOnly  bool          // Only show favorite items
 
 	// ========== NEUE: AUSGELAGERTE DATEN ==========
 	itemDatabase       ItemDatabase       // Loaded from JSON file
-	xpTable           map[int]int64       // Loaded from xp_table.json
 	itemNameMapping   map[string]string   // Loaded from item_names.json
 	areaNameMapping   map[string]string   // Loaded from area_names.json
+	priceTable        PriceTable          // Loaded from price_table.json
+	priceTablePath    string              // Where price table edits are saved
+	priceLookup       map[string]map[string]float64 // Lowercase price lookup per category
 }
 
 // ========== CONSTRUCTOR ==========
@@ -192,12 +339,22 @@ func NewApp() *App {
 		sessionStartTime: now,
 		xpTracking:       XPTracking{},
 		xpRunHistory:     make([]int64, 0),
+		// ========== RUN RECORDS INITIALIZATION ==========
+		runRecords:         make([]RunRecord, 0),
+		runAreaTime:        make(map[string]time.Duration),
+		runAreaXP:          make(map[string]int64),
+		trackedItems:       make(map[data.UnitID]*trackedItem),
+		shoppingSeen:       make(map[data.UnitID]bool),
+		runewordCandidates: make(map[data.UnitID]*runewordCandidate),
+		xpHistory:          XPHistory{InProgress: make(map[string]*LevelTimer)},
+		equipmentLog:       EquipmentLog{Equipped: make(map[string]map[string]EquippedItem)},
+		runLoadoutTime:     make(map[string]time.Duration),
+		mercLog:            MercLog{Current: make(map[string]MercState)},
 		// ========== ITEM DISPLAY INITIALIZATION ==========
 		itemsPerPage:     50,     // Standard: 50 Items pro Seite
 		showAllItems:     false,  // Standard: Pagination
 		// ========== NEUE: DATEN INITIALIZATION ==========
 		itemDatabase:     ItemDatabase{},
-		xpTable:          make(map[int]int64),
 		itemNameMapping:  make(map[string]string),
 		areaNameMapping:  make(map[string]string),
 	}
@@ -220,9 +377,9 @@ func (a *App) loadAllDataFiles() error {
 		errors = append(errors, fmt.Sprintf("items.json: %v", err))
 	}
 
-	// Load XP table
-	if err := a.loadXPTable(); err != nil {
-		errors = append(errors, fmt.Sprintf("xp_table.json: %v", err))
+	// Validate the embedded experience table
+	if err := validateExperienceTable(); err != nil {
+		errors = append(errors, fmt.Sprintf("experience table: %v", err))
 	}
 
 	// Load item name mapping
@@ -235,58 +392,22 @@ func (a *App) loadAllDataFiles() error {
 		errors = append(errors, fmt.Sprintf("area_names.json: %v", err))
 	}
 
-	if len(errors) > 0 {
-		return fmt.Errorf("failed to load: %s", strings.Join(errors, ", "))
-	}
-
-	fmt.Println("✅ All data files loaded successfully")
-	return nil
-}
-
-// ========== NEUE: LOAD XP TABLE ==========
-func (a *App) loadXPTable() error {
-	exePath, err := os.Executable()
-	if err != nil {
-		return fmt.Errorf("could not get executable path: %v", err)
+	// Load price table
+	if err := a.loadPriceTable(); err != nil {
+		a.setPriceTable(PriceTable{}) // Items have no value until a price table is found
+		errors = append(errors, fmt.Sprintf("price_table.json: %v", err))
 	}
 
-	xpTablePath := filepath.Join(filepath.Dir(exePath), "xp_table.json")
-	
-	// Fallback: try current working directory
-	if _, err := os.Stat(xpTablePath); os.IsNotExist(err) {
-		xpTablePath = "xp_table.json"
+	// Load character -> profile mapping (optional)
+	if err := a.loadCharacterProfiles(); err != nil {
+		errors = append(errors, fmt.Sprintf("%s: %v", characterProfilesFile, err))
 	}
 
-	// Check if file exists
-	if _, err := os.Stat(xpTablePath); os.IsNotExist(err) {
-		fmt.Printf("⚠️ xp_table.json not found at: %s\n", xpTablePath)
-		return fmt.Errorf("xp_table.json not found")
-	}
-
-	// Read file
-	data, err := ioutil.ReadFile(xpTablePath)
-	if err != nil {
-		return fmt.Errorf("could not read xp_table.json: %v", err)
-	}
-
-	// Parse JSON into string->int64 map first
-	var stringMap map[string]int64
-	if err := json.Unmarshal(data, &stringMap); err != nil {
-		return fmt.Errorf("could not parse xp_table.json: %v", err)
-	}
-
-	// Convert to int->int64 map
-	a.xpTable = make(map[int]int64)
-	for levelStr, xp := range stringMap {
-		level, err := strconv.Atoi(levelStr)
-		if err != nil {
-			fmt.Printf("⚠️ Skipping invalid level: %s\n", levelStr)
-			continue
-		}
-		a.xpTable[level] = xp
+	if len(errors) > 0 {
+		return fmt.Errorf("failed to load: %s", strings.Join(errors, ", "))
 	}
 
-	fmt.Printf("✅ XP table loaded: %d levels\n", len(a.xpTable))
+	fmt.Println("✅ All data files loaded successfully")
 	return nil
 }
 
@@ -420,57 +541,6 @@ func (a *App) GetItemLists() ItemListResponse {
 	}
 }
 
-// ========== NEUE: XP FUNKTIONEN MIT EXTERNEN DATEN ==========
-func (a *App) getXPForLevel(level int) int64 {
-	if xp, exists := a.xpTable[level]; exists {
-		return xp
-	}
-	return 0
-}
-
-func (a *App) getXPToNextLevel(currentXP int64, currentLevel int) int64 {
-	// ========== SIMPLIFIED XP CALCULATION ==========
-	// Based on user's actual D2R data: Level 92 with 2,024,734,818 XP needs 2,097,310,703 for Level 93
-	
-	if currentLevel >= 90 {
-		// For high levels, use realistic progression based on actual D2R data
-		switch currentLevel {
-		case 90:
-			return int64(float64(currentXP) * 0.05) // ~5% more
-		case 91:
-			return int64(float64(currentXP) * 0.04) // ~4% more
-		case 92:
-			// User's exact data: needs 2,097,310,703 total, has 2,024,734,818
-			nextLevelTotal := int64(2097310703)
-			if currentXP >= nextLevelTotal {
-				return 0 // Already at or above next level
-			}
-			return nextLevelTotal - currentXP
-		case 93:
-			return int64(float64(currentXP) * 0.03) // ~3% more
-		case 94:
-			return int64(float64(currentXP) * 0.025) // ~2.5% more
-		case 95:
-			return int64(float64(currentXP) * 0.02) // ~2% more
-		case 96:
-			return int64(float64(currentXP) * 0.015) // ~1.5% more
-		case 97:
-			return int64(float64(currentXP) * 0.01) // ~1% more
-		case 98:
-			return int64(float64(currentXP) * 0.005) // ~0.5% more
-		default:
-			return 0 // Level 99 is max
-		}
-	}
-	
-	// For levels below 90, use the loaded table
-	nextLevelXP := a.getXPForLevel(currentLevel + 1)
-	if nextLevelXP == 0 {
-		return 0 // Max level reached
-	}
-	return nextLevelXP - currentXP
-}
-
 // ========== NEUE: ITEM NAME FUNCTION MIT EXTERNEN DATEN ==========
 func (a *App) getItemName(itm data.Item) string {
 	if itm.Name == "" {
@@ -569,6 +639,8 @@ func (a *App) GetStats() GameStats {
 		FiltersEnabled: a.filtersEnabled,
 		// ========== XP TRACKING & CHARACTER INFO ==========
 		XPTracking:       a.xpTracking,
+		XPRates:          a.getXPRates(),
+		CurrentRunType:   a.getCurrentRunType(),
 		PlayerLevel:      a.getPlayerLevel(),
 		PlayerClass:      a.getPlayerClassName(),
 		CurrentArea:      a.getCurrentAreaName(),
@@ -594,9 +666,24 @@ func (a *App) GetStats() GameStats {
 		stats.AverageRun = "-"
 	}
 
-	// ========== KORRIGIERTE ITEM-DATEN MIT RICHTIGEN INDIZES ==========
+	// ========== ITEM-DATEN (ERSTE SEITE) ==========
 	stats.ItemsData = a.getItemsData(0, a.itemsPerPage) // Erste Seite
 
+	// Item counts per quality (crafted, runewords, ...)
+	stats.QualityCounts = make(map[string]int)
+	for _, item := range a.itemHistory {
+		stats.QualityCounts[item.Quality]++
+	}
+
+	// Estimated item value
+	stats.ValueStats = a.getValueStats()
+
+	// Gold income and expenses
+	stats.Gold = a.getGoldStats()
+
+	// Mercenary
+	stats.Merc = a.getMercStatus()
+
 	// ========== RÜCKWÄRTSKOMPATIBILITÄT: Recent Items ==========
 	// Nur die letzten 10 Items für old clients
 	start := len(a.itemHistory) - 10
@@ -604,12 +691,7 @@ func (a *App) GetStats() GameStats {
 		start = 0
 	}
 	stats.RecentItems = make([]ItemEntry, len(a.itemHistory)-start)
-	for i, item := range a.itemHistory[start:] {
-		itemCopy := item
-		// KRITISCH: Setze den echten Array-Index
-		itemCopy.ArrayIndex = start + i
-		stats.RecentItems[i] = itemCopy
-	}
+	copy(stats.RecentItems, a.itemHistory[start:])
 
 	return stats
 }
@@ -648,37 +730,79 @@ func (a *App) SetItemsPerPage(itemsPerPage int) int {
 	return a.itemsPerPage
 }
 
+// SetItemQualityFilter restricts the item list to one quality; "" shows all qualities
+func (a *App) SetItemQualityFilter(quality string) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	normalized := normalizeItemQuality(quality)
+	if normalized == "" && strings.TrimSpace(quality) != "" {
+		return a.itemQualityFilter, fmt.Errorf("unknown item quality: %s", quality)
+	}
+
+	a.itemQualityFilter = normalized
+	fmt.Printf("📋 Item quality filter changed: '%s'\n", a.itemQualityFilter)
+	return a.itemQualityFilter, nil
+}
+
+func (a *App) GetItemQualities() []string {
+	return append([]string(nil), itemQualities...)
+}
+
+// getFilteredItemIndices returns the itemHistory indices matching the quality, tag and favorite filters
+func (a *App) getFilteredItemIndices() []int {
+	indices := make([]int, 0, len(a.itemHistory))
+	for i, item := range a.itemHistory {
+		if a.itemQualityFilter != "" && item.Quality != a.itemQualityFilter {
+			continue
+		}
+		if a.itemTagFilter != "" && !itemHasAllTags(item, []string{a.itemTagFilter}) {
+			continue
+		}
+		if a.showFavoritesOnly && !item.Favorite {
+			continue
+		}
+		indices = append(indices, i)
+	}
+	return indices
+}
+
 func (a *App) getItemsData(page int, itemsPerPage int) ItemsResponse {
-	totalItems := len(a.itemHistory)
+	indices := a.getFilteredItemIndices()
+	totalItems := len(indices)
 	
 	// Wenn keine Items vorhanden
 	if totalItems == 0 {
 		return ItemsResponse{
-			Items:        []ItemEntry{},
-			TotalItems:   0,
-			CurrentPage:  0,
-			ItemsPerPage: itemsPerPage,
-			TotalPages:   0,
-			ShowAll:      a.showAllItems,
+			Items:         []ItemEntry{},
+			TotalItems:    0,
+			CurrentPage:   0,
+			ItemsPerPage:  itemsPerPage,
+			TotalPages:    0,
+			ShowAll:       a.showAllItems,
+			QualityFilter: a.itemQualityFilter,
+			TagFilter:     a.itemTagFilter,
+			FavoritesOnly: a.showFavoritesOnly,
 		}
 	}
 
 	// Wenn alle Items angezeigt werden sollen
 	if a.showAllItems {
 		items := make([]ItemEntry, totalItems)
-		for i, item := range a.itemHistory {
-			itemCopy := item
-			itemCopy.ArrayIndex = i // Setze den echten Array-Index
-			items[i] = itemCopy
+		for i, idx := range indices {
+			items[i] = a.itemHistory[idx]
 		}
 		
 		return ItemsResponse{
-			Items:        items,
-			TotalItems:   totalItems,
-			CurrentPage:  0,
-			ItemsPerPage: totalItems,
-			TotalPages:   1,
-			ShowAll:      true,
+			Items:         items,
+			TotalItems:    totalItems,
+			CurrentPage:   0,
+			ItemsPerPage:  totalItems,
+			TotalPages:    1,
+			ShowAll:       true,
+			QualityFilter: a.itemQualityFilter,
+			TagFilter:     a.itemTagFilter,
+			FavoritesOnly: a.showFavoritesOnly,
 		}
 	}
 
@@ -710,21 +834,22 @@ func (a *App) getItemsData(page int, itemsPerPage int) ItemsResponse {
 	
 	// Items in umgekehrter Reihenfolge hinzufügen (neueste zuerst)
 	for i := end - 1; i >= start; i-- {
-		itemCopy := a.itemHistory[i]
-		itemCopy.ArrayIndex = i // KRITISCH: Setze den echten Array-Index
-		pageItems = append(pageItems, itemCopy)
+		pageItems = append(pageItems, a.itemHistory[indices[i]])
 	}
 
 	fmt.Printf("📋 getItemsData: page=%d, itemsPerPage=%d, totalItems=%d, start=%d, end=%d, pageItems=%d\n",
 		page, itemsPerPage, totalItems, start, end, len(pageItems))
 
 	return ItemsResponse{
-		Items:        pageItems,
-		TotalItems:   totalItems,
-		CurrentPage:  page,
-		ItemsPerPage: itemsPerPage,
-		TotalPages:   totalPages,
-		ShowAll:      false,
+		Items:         pageItems,
+		TotalItems:    totalItems,
+		CurrentPage:   page,
+		ItemsPerPage:  itemsPerPage,
+		TotalPages:    totalPages,
+		ShowAll:       false,
+		QualityFilter: a.itemQualityFilter,
+		TagFilter:     a.itemTagFilter,
+		FavoritesOnly: a.showFavoritesOnly,
 	}
 }
 
@@ -735,6 +860,7 @@ func (a *App) ResetKills() {
 	}
 	a.totalKills = 0
 	a.runTimes = []int64{}
+	a.runRecords = make([]RunRecord, 0)
 	a.currentRun = 1 // Reset to 1, not 0
 	a.runActive = false
 	a.previousCorpses = make(map[data.UnitID]CorpseInfo)
@@ -803,11 +929,7 @@ func (a *App) GetAllItems() []ItemEntry {
 	defer a.mu.RUnlock()
 
 	items := make([]ItemEntry, len(a.itemHistory))
-	for i, item := range a.itemHistory {
-		itemCopy := item
-		itemCopy.ArrayIndex = i // Setze korrekten Array-Index
-		items[i] = itemCopy
-	}
+	copy(items, a.itemHistory)
 	return items
 }
 
@@ -836,78 +958,19 @@ func (a *App) GetFilteredItems() []string {
 	}
 }
 
-// ========== KORRIGIERTE ITEM EDITING FUNKTION ==========
-
-func (a *App) EditItemName(itemIndex int, newName string) error {
-	// ========== CRITICAL: Separate mutex for item editing ==========
-	a.editMutex.Lock()
-	defer a.editMutex.Unlock()
-
-	// Read-Lock for validation
-	a.mu.RLock()
-
-	// Detailed validation
-	if itemIndex < 0 || itemIndex >= len(a.itemHistory) {
-		a.mu.RUnlock()
-		fmt.Printf("❌ EDIT ERROR: Invalid index %d (valid range: 0-%d)\n", itemIndex, len(a.itemHistory)-1)
-		return fmt.Errorf("invalid item index: %d (valid range: 0-%d)", itemIndex, len(a.itemHistory)-1)
-	}
+// ========== ITEM EDITING (BY STABLE ID) ==========
 
+func (a *App) EditItemName(itemID string, expectedVersion int, newName string) error {
 	if newName == "" {
-		a.mu.RUnlock()
 		fmt.Printf("❌ EDIT ERROR: Empty name provided\n")
 		return fmt.Errorf("new name cannot be empty")
 	}
 
-	// Additional debug info before change
-	fmt.Printf("🔍 EDIT ATTEMPT: Index=%d, Current='%s', New='%s'\n",
-		itemIndex, a.itemHistory[itemIndex].Name, newName)
-	fmt.Printf("🔍 ARRAY STATE: Length=%d, ItemExists=%t\n",
-		len(a.itemHistory), itemIndex < len(a.itemHistory))
-
-	// Backup old state for rollback
-	oldItem := a.itemHistory[itemIndex]
-
-	// Upgrade to Write-Lock for change
-	a.mu.RUnlock()
-	a.mu.Lock()
-
-	// ========== CRITICAL: Double-check after lock upgrade ==========
-	if itemIndex >= len(a.itemHistory) {
-		a.mu.Unlock()
-		fmt.Printf("❌ EDIT ERROR: Index became invalid after lock upgrade!\n")
-		return fmt.Errorf("item index became invalid during edit")
-	}
-
-	// Perform change
-	a.itemHistory[itemIndex].Name = newName
-
-	// Verify change
-	if a.itemHistory[itemIndex].Name != newName {
-		fmt.Printf("❌ EDIT FAILED: Name was not updated correctly!\n")
-		a.itemHistory[itemIndex] = oldItem // Rollback
-		a.mu.Unlock()
-		return fmt.Errorf("failed to update item name")
-	}
-
-	fmt.Printf("✅ EDIT SUCCESS: '%s' -> '%s' (Index: %d)\n", oldItem.Name, newName, itemIndex)
-	fmt.Printf("📋 VERIFIED ITEM: Name='%s', Quality='%s', Run=%d\n",
-		a.itemHistory[itemIndex].Name,
-		a.itemHistory[itemIndex].Quality,
-		a.itemHistory[itemIndex].RunIndex)
-
-	a.mu.Unlock()
-
-	// ========== CRITICAL: Synchronous saving instead of async ==========
-	err := a.saveCurrentProfileSync()
-	if err != nil {
-		fmt.Printf("⚠️ SAVE WARNING: Could not save profile: %v\n", err)
-		// But still return success since the change is in memory
-	} else {
-		fmt.Printf("💾 SAVE SUCCESS: Profile saved successfully\n")
-	}
-
-	return nil
+	// Recorded in the audit log so the rename can be undone
+	return a.modifyItems("rename", []ItemRef{{ID: itemID, Version: expectedVersion}}, func(item *ItemEntry) bool {
+		item.Name = newName
+		return true
+	}, fmt.Sprintf("Renamed item to '%s'", newName))
 }
 
 // ========== NEW SYNCHRONOUS SAVE FUNCTION ==========
@@ -944,6 +1007,19 @@ func (a *App) saveCurrentProfileSync() error {
 		// ========== XP TRACKING DATA ==========
 		XPTracking:   a.xpTracking,
 		XPRunHistory: a.xpRunHistory,
+		Runs:         a.runRecords,
+		AuditLog:     a.auditLog,
+		Tags:         a.tagVocabulary,
+		Gambles:      a.gambleHistory,
+		ShoppingRules:   a.shoppingRules,
+		ShoppingMatches: a.shoppingMatches,
+		Transmutes:      a.transmuteHistory,
+		Runewords:       a.runewordHistory,
+		Deaths:          a.deathHistory,
+		XPRateWindows:   a.xpRateSettings.normalized(),
+		XPHistory:       a.xpHistory.snapshot(),
+		Equipment:       a.equipmentLog.snapshot(),
+		Mercenary:       a.mercLog.snapshot(),
 	}
 	a.mu.RUnlock()
 
@@ -969,32 +1045,35 @@ func (a *App) ExportItems() (string, error) {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
 
-	if len(a.itemHistory) == 0 {
+	// Export honors the active item list filters (quality, tag, favorites)
+	indices := a.getFilteredItemIndices()
+	if len(indices) == 0 {
 		return "", fmt.Errorf("no items to export")
 	}
 
 	// CSV Header - clean column structure
-	csvData := "Run;Item Name;Quality;Date;Time\n"
+	csvData := "Run;Item Name;Quality;Affixes;Tags;Favorite;Note;Date;Time\n"
 
 	// Add each item with proper Excel formatting
-	for _, item := range a.itemHistory {
+	for _, idx := range indices {
+		item := a.itemHistory[idx]
+
 		// Format time as separate date and time columns
 		dateStr := item.Time.Format("2006-01-02")
 		timeStr := item.Time.Format("15:04:05")
 
-		// Clean item name (remove quotes, replace problematic chars)
-		itemName := strings.ReplaceAll(item.Name, ";", ",") // Replace semicolons with commas
-		itemName = strings.ReplaceAll(itemName, "\"", "'")  // Replace quotes with apostrophes
-
-		// Clean quality name
-		quality := strings.ReplaceAll(item.Quality, ";", ",")
+		favorite := ""
+		if item.Favorite {
+			favorite = "★"
+		}
 
 		// Use semicolon as delimiter for better Excel compatibility
-		csvData += fmt.Sprintf("%d;%s;%s;%s;%s\n",
-			item.RunIndex, itemName, quality, dateStr, timeStr)
+		csvData += fmt.Sprintf("%d;%s;%s;%s;%s;%s;%s;%s;%s\n",
+			item.RunIndex, cleanCSVField(item.Name), cleanCSVField(item.Quality), cleanCSVField(item.Affixes),
+			cleanCSVField(strings.Join(item.Tags, ", ")), favorite, cleanCSVField(item.Note), dateStr, timeStr)
 	}
 
-	fmt.Printf("📊 EXPORT: Generated CSV with %d items in clean column structure\n", len(a.itemHistory))
+	fmt.Printf("📊 EXPORT: Generated CSV with %d of %d items in clean column structure\n", len(indices), len(a.itemHistory))
 	return csvData, nil
 }
 
@@ -1013,6 +1092,29 @@ func (a *App) gameLoop() {
 		a.checkForNewItems()
 		// ========== XP TRACKING ==========
 		a.updateXPTracking()
+		// ========== CHARACTER PROFILES ==========
+		a.updateCharacterProfile()
+		a.updateCharacterSnapshot()
+		// ========== DEATHS ==========
+		a.updateDeathTracking()
+		// ========== MERCENARY ==========
+		a.updateMercTracking()
+		// ========== RUN AREA TRACKING ==========
+		a.updateRunAreaTracking()
+		// ========== STASH SNAPSHOTS ==========
+		a.updateStashTracking()
+		// ========== ITEM LIFECYCLE ==========
+		a.updateItemLifecycle()
+		// ========== GOLD TRACKING ==========
+		a.updateGoldTracking()
+		// ========== VENDOR SHOPPING ==========
+		a.updateShoppingScanner()
+		// ========== HORADRIC CUBE ==========
+		a.updateCubeTracking()
+		// ========== RUNEWORDS ==========
+		a.updateRunewordTracking()
+		// ========== EQUIPMENT ==========
+		a.updateEquipmentTracking()
 	}
 }
 
@@ -1033,13 +1135,17 @@ func (a *App) checkGameStatus() {
 				// Record run statistics
 				if a.xpTracking.XPThisRun > 0 {
 					a.xpRunHistory = append(a.xpRunHistory, a.xpTracking.XPThisRun)
-					// Keep only last 20 runs
+					// Keep only last 20 runs; every run stays in xpHistory.Runs
 					if len(a.xpRunHistory) > 20 {
 						a.xpRunHistory = a.xpRunHistory[1:]
 					}
 					fmt.Printf("📈 Run #%d XP: %d (Session Total: %d)\n", a.currentRun, a.xpTracking.XPThisRun, a.xpTracking.SessionXPGained)
 				}
 
+				// ========== RUN RECORD ==========
+				a.finishRunRecord(runDuration)
+				a.recordXPRun(runDuration)
+
 				a.runActive = false
 				// FIX: currentRun for next run
 				a.currentRun++
@@ -1057,6 +1163,19 @@ func (a *App) checkGameStatus() {
 			// Reset run-specific counters
 			a.xpTracking.XPThisRun = 0
 			a.xpTracking.RunStartXP = a.xpTracking.CurrentXP
+			a.xpRunCounted = false
+			a.characterChecked = false
+			a.runSnapshot = nil
+			a.runAreaTime = make(map[string]time.Duration)
+			a.runAreaXP = make(map[string]int64)
+			a.resetItemLifecycle()
+			a.resetGoldSampling()
+			a.resetShoppingScanner()
+			a.resetCubeTracking()
+			a.resetRunewordTracking()
+			a.resetDeathTracking()
+			a.resetEquipmentTracking()
+			a.resetMercTracking()
 
 			// Reset tracking
 			a.trackerInitialized = false
@@ -1109,6 +1228,10 @@ func (a *App) checkForNewItems() {
 	// Debug: Show new items in inventory
 	for key, newItem := range currentInventory {
 		if _, existed := a.lastInventory[key]; !existed {
+			// Bought and gambled items are tracked by the gold and gambling trackers
+			if gameData.OpenMenus.NPCShop {
+				continue
+			}
 			itemName := a.getItemName(newItem)
 			fmt.Printf("🆕 NEW ITEM IN INVENTORY: '%s'\n", itemName)
 
@@ -1158,7 +1281,14 @@ func (a *App) updateXPTracking() {
 			currentLevel, currentXP, a.xpTracking.CurrentLevel, a.xpTracking.CurrentXP)
 	}
 
+	// Another character's experience is a new baseline, not XP gained or lost
+	if gameData.PlayerUnit.Name != a.xpCharacter {
+		a.xpCharacter = gameData.PlayerUnit.Name
+		a.xpTracking.CurrentXP = 0
+	}
+
 	// Initialize XP tracking on first run
+	var xpGained int64
 	if a.xpTracking.CurrentXP == 0 && currentXP > 0 {
 		a.xpTracking.CurrentXP = currentXP
 		a.xpTracking.CurrentLevel = currentLevel
@@ -1166,9 +1296,10 @@ func (a *App) updateXPTracking() {
 		fmt.Printf("📈 XP tracking initialized: Level %d, %d XP\n", currentLevel, currentXP)
 	} else if currentXP > a.xpTracking.CurrentXP {
 		// XP gained
-		xpGained := currentXP - a.xpTracking.CurrentXP
+		xpGained = currentXP - a.xpTracking.CurrentXP
 		a.xpTracking.SessionXPGained += xpGained
 		a.xpTracking.XPThisRun += xpGained
+		a.runAreaXP[a.getAreaName(gameData.PlayerUnit.Area)] += xpGained
 
 		fmt.Printf("📈 XP gained: +%d (Total Session: %d, This Run: %d)\n",
 			xpGained, a.xpTracking.SessionXPGained, a.xpTracking.XPThisRun)
@@ -1176,19 +1307,33 @@ func (a *App) updateXPTracking() {
 		// Check for level up
 		if currentLevel > a.xpTracking.CurrentLevel {
 			fmt.Printf("🎉 LEVEL UP! %d -> %d\n", a.xpTracking.CurrentLevel, currentLevel)
+			a.recordLevelUps(gameData.PlayerUnit.Name, a.xpTracking.CurrentLevel, currentLevel)
 		}
+	} else if currentXP > 0 && currentXP < a.xpTracking.CurrentXP && currentLevel == a.xpTracking.CurrentLevel {
+		// XP lost (death penalty); dying never costs a level
+		xpLost := a.xpTracking.CurrentXP - currentXP
+		a.xpTracking.SessionXPLost += xpLost
+		a.addDeathLoss(xpLost, 0)
+
+		fmt.Printf("📉 XP lost: -%d (Total Session Lost: %d)\n", xpLost, a.xpTracking.SessionXPLost)
 	}
 
 	a.xpTracking.CurrentXP = currentXP
 	a.xpTracking.CurrentLevel = currentLevel
+	played := a.sampleXP(xpGained)
+	a.trackLevelTime(gameData.PlayerUnit.Name, currentLevel, played)
 
 	// Calculate XP to next level
 	a.xpTracking.XPToNextLevel = a.getXPToNextLevel(currentXP, currentLevel)
+	a.xpTracking.LevelProgress = a.getLevelProgress(currentXP, currentLevel)
+	a.xpTracking.XPToLevel99 = a.getXPToLevel99(currentXP)
 
-	// Calculate XP per hour
-	sessionDuration := time.Since(a.sessionStartTime).Hours()
-	if sessionDuration > 0 {
+	// Calculate XP per hour of play time, so breaks in the menu do not lower the rate.
+	// Skip the first minute, where a single kill would extrapolate to a huge rate.
+	if a.xpActiveTime >= time.Minute {
+		sessionDuration := a.xpActiveTime.Hours()
 		a.xpTracking.XPPerHour = float64(a.xpTracking.SessionXPGained) / sessionDuration
+		a.xpTracking.NetXPPerHour = float64(a.xpTracking.SessionXPGained-a.xpTracking.SessionXPLost) / sessionDuration
 	}
 
 	// ========== VERBESSERTE RUNS-TO-NEXT-LEVEL BERECHNUNG ==========
@@ -1259,59 +1404,18 @@ func (a *App) calculateRunsToNextLevel() {
 		return
 	}
 
-	// Method 4: Level-basierte Schätzung für hohe Level
-	if a.xpTracking.CurrentLevel >= 85 {
-		var typicalXPPerRun int64
-		switch {
-		case a.xpTracking.CurrentLevel >= 96:
-			typicalXPPerRun = 100000000 // 100M XP pro Run für Level 96+
-		case a.xpTracking.CurrentLevel >= 93:
-			typicalXPPerRun = 75000000  // 75M XP pro Run für Level 93-95
-		case a.xpTracking.CurrentLevel >= 90:
-			typicalXPPerRun = 50000000  // 50M XP pro Run für Level 90-92
-		case a.xpTracking.CurrentLevel >= 87:
-			typicalXPPerRun = 30000000  // 30M XP pro Run für Level 87-89
-		default:
-			typicalXPPerRun = 20000000  // 20M XP pro Run für Level 85-86
-		}
-		
-		estimatedRuns := int(a.xpTracking.XPToNextLevel / typicalXPPerRun)
-		if estimatedRuns > 0 {
-			a.xpTracking.RunsToNextLevel = estimatedRuns
-			a.xpTracking.EstimatedRunsToNext = estimatedRuns
-			a.xpTracking.AverageXPPerRun = typicalXPPerRun
-			a.xpTracking.RunsCalculationMethod = fmt.Sprintf("Level-based estimate (L%d)", a.xpTracking.CurrentLevel)
-			fmt.Printf("🎯 Method 4: Runs to next level: %d (level-based estimate for L%d)\n", 
-				estimatedRuns, a.xpTracking.CurrentLevel)
-			return
-		}
-	}
-
-	// Method 5: General fallback für niedrigere Level
-	if a.xpTracking.CurrentLevel < 85 {
-		// Für niedrigere Level: geschätzte XP pro Run basierend auf Level
-		var typicalXPPerRun int64
-		switch {
-		case a.xpTracking.CurrentLevel >= 70:
-			typicalXPPerRun = 10000000 // 10M XP pro Run für Level 70-84
-		case a.xpTracking.CurrentLevel >= 50:
-			typicalXPPerRun = 5000000  // 5M XP pro Run für Level 50-69
-		case a.xpTracking.CurrentLevel >= 30:
-			typicalXPPerRun = 1000000  // 1M XP pro Run für Level 30-49
-		default:
-			typicalXPPerRun = 100000   // 100K XP pro Run für Level unter 30
-		}
-		
-		estimatedRuns := int(a.xpTracking.XPToNextLevel / typicalXPPerRun)
-		if estimatedRuns > 0 {
-			a.xpTracking.RunsToNextLevel = estimatedRuns
-			a.xpTracking.EstimatedRunsToNext = estimatedRuns
-			a.xpTracking.AverageXPPerRun = typicalXPPerRun
-			a.xpTracking.RunsCalculationMethod = fmt.Sprintf("General estimate (L%d)", a.xpTracking.CurrentLevel)
-			fmt.Printf("🎯 Method 5: Runs to next level: %d (general estimate for L%d)\n", 
-				estimatedRuns, a.xpTracking.CurrentLevel)
-			return
-		}
+	// Method 4: Rolling XP rate and average run time
+	rates := a.getXPRates()
+	if _, _, averageRun := a.getRunStats(); averageRun > 0 && rates.NextLevel.Hours > 0 {
+		runHours := (time.Duration(averageRun) * time.Millisecond).Hours()
+		estimatedRuns := int(math.Ceil(rates.NextLevel.Hours / runHours))
+		a.xpTracking.RunsToNextLevel = estimatedRuns
+		a.xpTracking.EstimatedRunsToNext = estimatedRuns
+		a.xpTracking.AverageXPPerRun = rates.NextLevel.XPNeeded / int64(estimatedRuns)
+		a.xpTracking.RunsCalculationMethod = fmt.Sprintf("XP rate (%s)", rates.Basis)
+		fmt.Printf("🎯 Method 4: Runs to next level: %d (%s rate %.0f XP/h)\n",
+			estimatedRuns, rates.Basis, float64(rates.NextLevel.XPNeeded)/rates.NextLevel.Hours)
+		return
 	}
 
 	// Fallback: Keine Schätzung möglich
@@ -1350,6 +1454,8 @@ func (a *App) onItemPickedUp(itm data.Item) {
 	affixesText := a.getItemAffixes(itm)
 
 	itemEntry := ItemEntry{
+		ID:           newItemID(),
+		Version:      1,
 		Name:         itemName,
 		OriginalName: itemName, // Store original for later
 		Quality:      a.getItemQuality(itm),
@@ -1360,12 +1466,15 @@ func (a *App) onItemPickedUp(itm data.Item) {
 		IsEthereal:   itm.Ethereal,
 		IsIdentified: itm.Identified,
 		ItemLevel:    itm.LevelReq,
-		// ArrayIndex wird später gesetzt
+		Area:         a.getCurrentAreaName(),
 	}
+	itemEntry.Value = a.estimateItemValue(itemEntry)
+	itemEntry.Charm = a.classifyCharm(itm)
+	a.trackPickedUpItem(&itemEntry, itm)
 
 	a.itemHistory = append(a.itemHistory, itemEntry)
-	fmt.Printf("📦 ITEM ADDED TO HISTORY: %s (%s) - Run %d (Index: %d)\n", 
-		itemName, itemEntry.Quality, a.currentRun, len(a.itemHistory)-1)
+	fmt.Printf("📦 ITEM ADDED TO HISTORY: %s (%s) - Run %d (ID: %s)\n", 
+		itemName, itemEntry.Quality, a.currentRun, itemEntry.ID)
 
 	// Enhanced logging for special items
 	if affixesText != "" {
@@ -1374,6 +1483,9 @@ func (a *App) onItemPickedUp(itm data.Item) {
 	if itm.Ethereal {
 		fmt.Printf("👻 ETHEREAL ITEM: %s\n", itemName)
 	}
+	if itemEntry.Charm != nil {
+		fmt.Printf("🧿 %s: %s (%s)\n", strings.ToUpper(itemEntry.Charm.Kind), itemEntry.Charm.Class, strings.Join(itemEntry.Charm.Rolls, ", "))
+	}
 
 	go a.SaveCurrentProfile()
 }
@@ -1487,6 +1599,19 @@ func (a *App) LoadProfile(profile string) {
 		// ========== XP TRACKING INITIALIZATION ==========
 		a.xpTracking = XPTracking{SessionXPGained: 0, XPThisRun: 0}
 		a.xpRunHistory = make([]int64, 0)
+		a.runRecords = make([]RunRecord, 0)
+		a.auditLog = make([]AuditEntry, 0)
+		a.tagVocabulary = make([]string, 0)
+		a.gambleHistory = make([]GambleRecord, 0)
+		a.shoppingRules = make([]ShoppingRule, 0)
+		a.shoppingMatches = make([]ShoppingMatch, 0)
+		a.transmuteHistory = make([]CubeTransmute, 0)
+		a.runewordHistory = make([]RunewordRecord, 0)
+		a.deathHistory = make([]DeathRecord, 0)
+		a.xpRateSettings = XPRateSettings{}
+		a.xpHistory = XPHistory{}
+		a.equipmentLog = EquipmentLog{}
+		a.mercLog = MercLog{}
 		a.sessionStartTime = time.Now()
 	} else {
 		defer file.Close()
@@ -1502,6 +1627,19 @@ func (a *App) LoadProfile(profile string) {
 			// ========== XP TRACKING INITIALIZATION ==========
 			a.xpTracking = XPTracking{SessionXPGained: 0, XPThisRun: 0}
 			a.xpRunHistory = make([]int64, 0)
+			a.runRecords = make([]RunRecord, 0)
+			a.auditLog = make([]AuditEntry, 0)
+			a.tagVocabulary = make([]string, 0)
+			a.gambleHistory = make([]GambleRecord, 0)
+			a.shoppingRules = make([]ShoppingRule, 0)
+			a.shoppingMatches = make([]ShoppingMatch, 0)
+			a.transmuteHistory = make([]CubeTransmute, 0)
+			a.runewordHistory = make([]RunewordRecord, 0)
+			a.deathHistory = make([]DeathRecord, 0)
+			a.xpRateSettings = XPRateSettings{}
+			a.xpHistory = XPHistory{}
+			a.equipmentLog = EquipmentLog{}
+			a.mercLog = MercLog{}
 			a.sessionStartTime = time.Now()
 		} else {
 			a.killCounts = data.KillCounts
@@ -1514,16 +1652,59 @@ func (a *App) LoadProfile(profile string) {
 			// ========== XP TRACKING DATA LOADING ==========
 			a.xpTracking = data.XPTracking
 			a.xpRunHistory = data.XPRunHistory
+			a.runRecords = data.Runs
+			a.auditLog = data.AuditLog
+			a.tagVocabulary = data.Tags
+			a.gambleHistory = data.Gambles
+			a.shoppingRules = data.ShoppingRules
+			a.shoppingMatches = data.ShoppingMatches
+			a.transmuteHistory = data.Transmutes
+			a.runewordHistory = data.Runewords
+			a.deathHistory = data.Deaths
+			a.xpRateSettings = data.XPRateWindows.normalized()
+			a.xpHistory = data.XPHistory
+			a.equipmentLog = data.Equipment
+			a.mercLog = data.Mercenary
 			a.sessionStartTime = time.Now() // Reset session start time on profile load
 			
 			// CRITICAL: Reset session-specific tracking when loading profile
 			a.xpTracking.SessionXPGained = 0
 			a.xpTracking.XPThisRun = 0
+			a.xpTracking.SessionXPLost = 0
+			a.xpTracking.NetXPPerHour = 0
+			a.xpTracking.SessionDeaths = 0
 
 			// Initialize tracking if data is missing
 			if a.xpRunHistory == nil {
 				a.xpRunHistory = make([]int64, 0)
 			}
+			if a.runRecords == nil {
+				a.runRecords = make([]RunRecord, 0)
+			}
+			if a.auditLog == nil {
+				a.auditLog = make([]AuditEntry, 0)
+			}
+			if a.tagVocabulary == nil {
+				a.tagVocabulary = make([]string, 0)
+			}
+			if a.gambleHistory == nil {
+				a.gambleHistory = make([]GambleRecord, 0)
+			}
+			if a.shoppingRules == nil {
+				a.shoppingRules = make([]ShoppingRule, 0)
+			}
+			if a.shoppingMatches == nil {
+				a.shoppingMatches = make([]ShoppingMatch, 0)
+			}
+			if a.transmuteHistory == nil {
+				a.transmuteHistory = make([]CubeTransmute, 0)
+			}
+			if a.runewordHistory == nil {
+				a.runewordHistory = make([]RunewordRecord, 0)
+			}
+			if a.deathHistory == nil {
+				a.deathHistory = make([]DeathRecord, 0)
+			}
 		}
 	}
 
@@ -1533,6 +1714,17 @@ func (a *App) LoadProfile(profile string) {
 	if a.itemHistory == nil {
 		a.itemHistory = []ItemEntry{}
 	}
+	a.ensureXPHistory()
+	a.ensureEquipmentLog()
+	a.ensureMercLog()
+	a.ensureItemIDs()
+	a.recalculateItemValues()
+	a.nextAuditID = 0
+	for _, entry := range a.auditLog {
+		if entry.ID > a.nextAuditID {
+			a.nextAuditID = entry.ID
+		}
+	}
 
 	a.currentProfile = profile
 	a.runActive = false
@@ -1541,6 +1733,21 @@ func (a *App) LoadProfile(profile string) {
 	a.lastInventory = make(map[string]data.Item)
 	a.lastGroundItems = make(map[string]data.Item)
 	a.itemsFromGround = make(map[string]time.Time)
+	a.runAreaTime = make(map[string]time.Duration)
+	a.runAreaXP = make(map[string]int64)
+	a.resetItemLifecycle()
+	a.resetShoppingScanner()
+	a.resetCubeTracking()
+	a.resetRunewordTracking()
+	a.resetDeathTracking()
+	a.resetXPSampling()
+	a.resetEquipmentTracking()
+	a.resetMercTracking()
+	a.runLoadoutTag = ""
+	a.runSnapshot = nil
+	a.sessionGold = GoldBreakdown{}
+	a.goldActiveTime = 0
+	a.lastGoldTick = time.Time{}
 }
 
 // ========== PROFILE LOADING ==========
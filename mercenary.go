@@ -0,0 +1,367 @@
+// mercenary.go - Mercenary Deaths, Resurrections & Level for D2R Tracker
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/mode"
+	"github.com/hectorgimenez/d2go/pkg/data/npc"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+// A merc disappearing this soon after its death animation is the same death
+const mercDeathGrace = 10 * time.Second
+
+// ========== MERCENARY STRUCTURES ==========
+
+// MercLog is stored per profile
+type MercLog struct {
+	Deaths     []MercDeath          `json:"deaths"`
+	Resurrects []MercResurrect      `json:"resurrects"`
+	Levels     []MercLevelRecord    `json:"levels"`
+	Current    map[string]MercState `json:"current"` // Character -> last known merc
+}
+
+type MercDeath struct {
+	Time      time.Time `json:"time"`
+	RunIndex  int       `json:"run_index"`
+	Area      string    `json:"area,omitempty"`
+	Character string    `json:"character,omitempty"`
+	Type      string    `json:"type,omitempty"`
+	Level     int       `json:"level"`
+	Detection string    `json:"detection"` // "dead mode" or "merc lost"
+}
+
+// MercResurrect is gold paid while the merc came back (resurrection or a new hire)
+type MercResurrect struct {
+	Time      time.Time `json:"time"`
+	RunIndex  int       `json:"run_index"`
+	Character string    `json:"character,omitempty"`
+	Gold      int       `json:"gold"`
+}
+
+type MercLevelRecord struct {
+	Time      time.Time `json:"time"`
+	RunIndex  int       `json:"run_index"`
+	Character string    `json:"character,omitempty"`
+	Type      string    `json:"type,omitempty"`
+	Level     int       `json:"level"`
+}
+
+type MercState struct {
+	Type    string    `json:"type"` // e.g. "Desert Mercenary (Act 2)"
+	Level   int       `json:"level"`
+	Alive   bool      `json:"alive"`
+	Updated time.Time `json:"updated"` // Last change of type, level or alive state
+}
+
+// MercStatus is the merc of the current character with session and run counters
+type MercStatus struct {
+	Character            string  `json:"character"`
+	Known                bool    `json:"known"` // The character's merc was seen at least once
+	Type                 string  `json:"type"`
+	Level                int     `json:"level"`
+	Alive                bool    `json:"alive"`
+	RunDeaths            int     `json:"run_deaths"`
+	SessionDeaths        int     `json:"session_deaths"`
+	TotalDeaths          int     `json:"total_deaths"`
+	SessionResurrectGold int     `json:"session_resurrect_gold"`
+	TotalResurrectGold   int     `json:"total_resurrect_gold"`
+	DeathsPerRun         float64 `json:"deaths_per_run"` // Merc deaths per run of this character
+}
+
+// MercHistory is the merc's level and gear over time
+type MercHistory struct {
+	Character  string                  `json:"character"`
+	Levels     []MercLevelRecord       `json:"levels"`     // Level when first seen and every change after, oldest first
+	Gear       map[string]EquippedItem `json:"gear"`       // Current merc gear by body location
+	GearLog    []EquipmentChange       `json:"gear_log"`   // Merc gear swaps, oldest first
+	Deaths     []MercDeath             `json:"deaths"`     // Oldest first
+	Resurrects []MercResurrect         `json:"resurrects"` // Oldest first
+	ByArea     map[string]int          `json:"by_area"`    // Merc deaths per area
+}
+
+// mercTypeNames maps hireling units to the name shown at the hireling vendors
+var mercTypeNames = map[npc.ID]string{
+	npc.Rogue2:            "Rogue Scout (Act 1)",
+	npc.Guard:             "Desert Mercenary (Act 2)",
+	npc.IronWolf:          "Iron Wolf (Act 3)",
+	npc.Act5Hireling1Hand: "Barbarian (Act 5)",
+	npc.Act5Hireling2Hand: "Barbarian (Act 5)",
+}
+
+// ========== MERCENARY TRACKING ==========
+
+// updateMercTracking watches the merc for deaths and level changes
+func (a *App) updateMercTracking() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	gameData := a.lastGameData
+	character := gameData.PlayerUnit.Name
+	if a.wasInMenu || !a.runActive || gameData.PlayerUnit.Area == 0 || character == "" {
+		return
+	}
+
+	merc, found := findMerc(gameData)
+	alive := gameData.HasMerc
+	dying := found && (merc.Mode == mode.NpcDeath || merc.Mode == mode.NpcDead)
+
+	wasAlive := a.mercWasAlive
+	wasDying := a.mercWasDying
+	sampled := a.mercSampled
+	a.mercWasAlive = alive && !dying
+	a.mercWasDying = dying
+	a.mercSampled = true
+
+	previous, known := a.mercLog.Current[character]
+	state := previous
+	if found {
+		state.Type = mercTypeNames[merc.Name]
+		if level := merc.Stats[stat.Level]; level > 0 && level != state.Level {
+			a.mercLog.Levels = append(a.mercLog.Levels, MercLevelRecord{
+				Time:      time.Now(),
+				RunIndex:  a.currentRun,
+				Character: character,
+				Type:      state.Type,
+				Level:     level,
+			})
+			if state.Level > 0 {
+				fmt.Printf("🗡️ Merc reached level %d\n", level)
+			}
+			state.Level = level
+		}
+	}
+	state.Alive = alive && !dying
+	// Only write on changes; the profile save copies this map while a game is running
+	if !known || state != previous {
+		state.Updated = time.Now()
+		a.mercLog.Current[character] = state
+	}
+
+	// A merc that was already dead when the tracker joined the game is not a new death
+	if !sampled {
+		return
+	}
+
+	switch {
+	case dying && !wasDying:
+		a.recordMercDeath(character, state, "dead mode")
+	case !alive && wasAlive && time.Since(a.lastMercDeath) > mercDeathGrace:
+		a.recordMercDeath(character, state, "merc lost")
+	}
+}
+
+// recordMercDeath stores a merc death. Must be called with a.mu held.
+func (a *App) recordMercDeath(character string, state MercState, detection string) {
+	record := MercDeath{
+		Time:      time.Now(),
+		RunIndex:  a.currentRun,
+		Area:      a.getCurrentAreaName(),
+		Character: character,
+		Type:      state.Type,
+		Level:     state.Level,
+		Detection: detection,
+	}
+	a.mercLog.Deaths = append(a.mercLog.Deaths, record)
+	a.lastMercDeath = record.Time
+
+	fmt.Printf("⚰️ MERC DEATH in %s (Run #%d, Level %d, detected by %s)\n", record.Area, record.RunIndex, record.Level, detection)
+}
+
+// recordMercResurrect logs gold paid for bringing the merc back. Must be called with a.mu held.
+func (a *App) recordMercResurrect(gold int) {
+	a.mercLog.Resurrects = append(a.mercLog.Resurrects, MercResurrect{
+		Time:      time.Now(),
+		RunIndex:  a.currentRun,
+		Character: a.lastGameData.PlayerUnit.Name,
+		Gold:      gold,
+	})
+	fmt.Printf("⚰️ Merc resurrected for %d gold\n", gold)
+}
+
+// resetMercTracking starts sampling the merc again in a new game. Must be called with a.mu held.
+func (a *App) resetMercTracking() {
+	a.mercSampled = false
+	a.mercWasAlive = false
+	a.mercWasDying = false
+}
+
+// ensureMercLog fills in missing parts of a loaded log. Must be called with a.mu held.
+func (a *App) ensureMercLog() {
+	if a.mercLog.Deaths == nil {
+		a.mercLog.Deaths = make([]MercDeath, 0)
+	}
+	if a.mercLog.Resurrects == nil {
+		a.mercLog.Resurrects = make([]MercResurrect, 0)
+	}
+	if a.mercLog.Levels == nil {
+		a.mercLog.Levels = make([]MercLevelRecord, 0)
+	}
+	if a.mercLog.Current == nil {
+		a.mercLog.Current = make(map[string]MercState)
+	}
+}
+
+// snapshot copies the log for encoding after a.mu is released. Must be called with a.mu held.
+func (l MercLog) snapshot() MercLog {
+	current := make(map[string]MercState, len(l.Current))
+	for character, state := range l.Current {
+		current[character] = state
+	}
+	l.Current = current
+	return l
+}
+
+// countRunDeaths returns player and merc deaths of a run. Must be called with a.mu held.
+func (a *App) countRunDeaths(runIndex int) (int, int) {
+	deaths := 0
+	for _, death := range a.deathHistory {
+		if death.RunIndex == runIndex {
+			deaths++
+		}
+	}
+	mercDeaths := 0
+	for _, death := range a.mercLog.Deaths {
+		if death.RunIndex == runIndex {
+			mercDeaths++
+		}
+	}
+	return deaths, mercDeaths
+}
+
+// findMerc returns the player's hireling from the monster list. d2go does not
+// expose the owner of a monster, so with other players' hirelings in range no
+// merc is returned rather than possibly the wrong one.
+func findMerc(gameData data.Data) (data.Monster, bool) {
+	var merc data.Monster
+	found := 0
+	for _, monster := range gameData.Monsters {
+		if monster.IsMerc() {
+			merc = monster
+			found++
+		}
+	}
+	if found != 1 {
+		return data.Monster{}, false
+	}
+	return merc, true
+}
+
+// ========== MERCENARY API ==========
+
+// getMercStatus summarizes the merc of the current (or last played) character. Must be called with a.mu held.
+func (a *App) getMercStatus() MercStatus {
+	character := a.lastGameData.PlayerUnit.Name
+	status := MercStatus{Character: character}
+	state, known := a.mercLog.Current[character]
+	status.Known = known
+	status.Type = state.Type
+	status.Level = state.Level
+	status.Alive = state.Alive
+
+	for _, death := range a.mercLog.Deaths {
+		if death.Character != character {
+			continue
+		}
+		status.TotalDeaths++
+		if !death.Time.Before(a.sessionStartTime) {
+			status.SessionDeaths++
+		}
+		if death.RunIndex == a.currentRun && a.runActive {
+			status.RunDeaths++
+		}
+	}
+	for _, resurrect := range a.mercLog.Resurrects {
+		if resurrect.Character != character {
+			continue
+		}
+		status.TotalResurrectGold += resurrect.Gold
+		if !resurrect.Time.Before(a.sessionStartTime) {
+			status.SessionResurrectGold += resurrect.Gold
+		}
+	}
+
+	runs := 0
+	for _, record := range a.runRecords {
+		if record.Character != nil && record.Character.Character == character {
+			runs++
+		}
+	}
+	if runs > 0 {
+		status.DeathsPerRun = float64(status.TotalDeaths) / float64(runs)
+	}
+	return status
+}
+
+func (a *App) GetMercStatus() MercStatus {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return a.getMercStatus()
+}
+
+// GetMercDeaths returns the newest merc deaths first; limit <= 0 returns all
+func (a *App) GetMercDeaths(limit int) []MercDeath {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	deaths := make([]MercDeath, 0, len(a.mercLog.Deaths))
+	for i := len(a.mercLog.Deaths) - 1; i >= 0; i-- {
+		if limit > 0 && len(deaths) >= limit {
+			break
+		}
+		deaths = append(deaths, a.mercLog.Deaths[i])
+	}
+	return deaths
+}
+
+// GetMercHistory returns level, gear, deaths and resurrections of a character's merc.
+// An empty character uses the character currently (or last) played.
+func (a *App) GetMercHistory(character string) MercHistory {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if character == "" {
+		character = a.lastGameData.PlayerUnit.Name
+	}
+	history := MercHistory{
+		Character:  character,
+		Levels:     make([]MercLevelRecord, 0),
+		Gear:       make(map[string]EquippedItem),
+		GearLog:    make([]EquipmentChange, 0),
+		Deaths:     make([]MercDeath, 0),
+		Resurrects: make([]MercResurrect, 0),
+		ByArea:     make(map[string]int),
+	}
+	for _, record := range a.mercLog.Levels {
+		if record.Character == character {
+			history.Levels = append(history.Levels, record)
+		}
+	}
+	for slot, equipped := range a.equipmentLog.Equipped[character] {
+		if bodyLocation, found := strings.CutPrefix(slot, "merc:"); found {
+			history.Gear[bodyLocation] = equipped
+		}
+	}
+	for _, change := range a.equipmentLog.Changes {
+		if change.Character == character && change.Owner == "merc" {
+			history.GearLog = append(history.GearLog, change)
+		}
+	}
+	for _, death := range a.mercLog.Deaths {
+		if death.Character == character {
+			history.Deaths = append(history.Deaths, death)
+			history.ByArea[death.Area]++
+		}
+	}
+	for _, resurrect := range a.mercLog.Resurrects {
+		if resurrect.Character == character {
+			history.Resurrects = append(history.Resurrects, resurrect)
+		}
+	}
+	return history
+}
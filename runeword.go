@@ -0,0 +1,240 @@
+// runeword.go - Runeword Creation Log for D2R Tracker
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+)
+
+// ========== RUNEWORD STRUCTURES ==========
+
+type RunewordRecord struct {
+	Time     time.Time `json:"time"`
+	RunIndex int       `json:"run_index"`
+	Area     string    `json:"area,omitempty"`
+	Runeword string    `json:"runeword"`
+	Base     string    `json:"base"`
+	Ethereal bool      `json:"ethereal,omitempty"`
+	Runes    []string  `json:"runes"` // Runes consumed, in socket order when known
+	Stats    []string  `json:"stats"` // Resulting rolls
+}
+
+type RunewordStats struct {
+	Runewords  int            `json:"runewords"`
+	ByRuneword map[string]int `json:"by_runeword"`
+	RunesFound int            `json:"runes_found"`
+	RunesSpent int            `json:"runes_spent"` // In runewords and cube transmutes
+	Runes      []RuneBalance  `json:"runes"`       // Highest rune first
+}
+
+// RuneBalance compares how often a rune was found and spent
+type RuneBalance struct {
+	Rune           string `json:"rune"`
+	Found          int    `json:"found"`
+	SpentRunewords int    `json:"spent_runewords"`
+	SpentCube      int    `json:"spent_cube"`
+	Balance        int    `json:"balance"` // Found - spent
+}
+
+// runewordCandidate is a socketed base seen without a runeword in the current game
+type runewordCandidate struct {
+	runeCounts map[string]int // Carried runes when the base was first seen
+}
+
+// ========== RUNEWORD DETECTION ==========
+
+func (a *App) updateRunewordTracking() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	gameData := a.lastGameData
+	if a.wasInMenu || gameData.PlayerUnit.Area == 0 || len(gameData.Inventory.AllItems) == 0 {
+		return
+	}
+
+	var runeCounts map[string]int
+	for _, itm := range gameData.Inventory.AllItems {
+		switch itm.Location.LocationType {
+		case item.LocationInventory, item.LocationStash, item.LocationSharedStash:
+		default:
+			continue
+		}
+		if itemSockets(itm) == 0 || itm.IsInSocket {
+			continue
+		}
+
+		candidate, watched := a.runewordCandidates[itm.UnitID]
+		if !itm.IsRuneword {
+			if !watched && itm.Quality <= item.QualitySuperior {
+				if runeCounts == nil {
+					runeCounts = a.carriedRuneCounts(gameData)
+				}
+				a.runewordCandidates[itm.UnitID] = &runewordCandidate{runeCounts: runeCounts}
+			}
+			continue
+		}
+		if !watched {
+			continue // Runeword made before the tracker saw the base
+		}
+
+		if runeCounts == nil {
+			runeCounts = a.carriedRuneCounts(gameData)
+		}
+		a.recordRuneword(itm, a.consumedRunes(itm, candidate.runeCounts, runeCounts))
+		delete(a.runewordCandidates, itm.UnitID)
+	}
+}
+
+// recordRuneword stores a new runeword. Must be called with a.mu held.
+func (a *App) recordRuneword(itm data.Item, runes []string) {
+	record := RunewordRecord{
+		Time:     time.Now(),
+		RunIndex: a.currentRun,
+		Area:     a.getCurrentAreaName(),
+		Runeword: string(itm.RunewordName),
+		Base:     a.getItemName(itm),
+		Ethereal: itm.Ethereal,
+		Runes:    runes,
+		Stats:    describeItemStats(itm),
+	}
+	if record.Runeword == "" {
+		record.Runeword = itm.IdentifiedName
+	}
+	a.runewordHistory = append(a.runewordHistory, record)
+
+	fmt.Printf("🔮 RUNEWORD CREATED: %s in %s (%s)\n", record.Runeword, record.Base, strings.Join(runes, " + "))
+}
+
+// resetRunewordTracking forgets unit IDs, which are only valid within one game. Must be called with a.mu held.
+func (a *App) resetRunewordTracking() {
+	a.runewordCandidates = make(map[data.UnitID]*runewordCandidate)
+}
+
+// consumedRunes reads the runes from the item's sockets; when memory did not link the
+// sockets, the runes that left the carried rune inventory since the base was first seen are used.
+// Must be called with a.mu held.
+func (a *App) consumedRunes(itm data.Item, before map[string]int, after map[string]int) []string {
+	runes := make([]string, 0, itemSockets(itm))
+	for _, socketItem := range itm.Sockets {
+		if isRuneItem(socketItem) {
+			runes = append(runes, a.getItemName(socketItem))
+		}
+	}
+	if len(runes) > 0 {
+		return runes
+	}
+
+	for name, count := range before {
+		for i := after[name]; i < count && len(runes) < itemSockets(itm); i++ {
+			runes = append(runes, name)
+		}
+	}
+	sort.Slice(runes, func(i, j int) bool { return runeRank(runes[i]) < runeRank(runes[j]) })
+	return runes
+}
+
+// carriedRuneCounts counts loose runes by name. Must be called with a.mu held.
+func (a *App) carriedRuneCounts(gameData data.Data) map[string]int {
+	counts := make(map[string]int)
+	for _, itm := range gameData.Inventory.AllItems {
+		switch itm.Location.LocationType {
+		case item.LocationVendor, item.LocationGround, item.LocationSocket:
+			continue
+		}
+		if itm.IsInSocket || !isRuneItem(itm) {
+			continue
+		}
+		counts[a.getItemName(itm)]++
+	}
+	return counts
+}
+
+// ========== RUNEWORD API ==========
+
+// GetRunewordHistory returns the newest runewords first; limit <= 0 returns all
+func (a *App) GetRunewordHistory(limit int) []RunewordRecord {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	records := make([]RunewordRecord, 0, len(a.runewordHistory))
+	for i := len(a.runewordHistory) - 1; i >= 0; i-- {
+		if limit > 0 && len(records) >= limit {
+			break
+		}
+		records = append(records, a.runewordHistory[i])
+	}
+	return records
+}
+
+// GetRunewordStats counts runewords and compares runes found with runes spent
+func (a *App) GetRunewordStats() RunewordStats {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	stats := RunewordStats{ByRuneword: make(map[string]int), Runes: []RuneBalance{}}
+	balances := make(map[string]*RuneBalance)
+	balance := func(name string) *RuneBalance {
+		b, found := balances[name]
+		if !found {
+			b = &RuneBalance{Rune: name}
+			balances[name] = b
+		}
+		return b
+	}
+
+	for _, entry := range a.itemHistory {
+		if strings.HasSuffix(entry.OriginalName, " Rune") {
+			balance(entry.OriginalName).Found++
+			stats.RunesFound++
+		}
+	}
+	for _, record := range a.runewordHistory {
+		stats.Runewords++
+		stats.ByRuneword[record.Runeword]++
+		for _, name := range record.Runes {
+			balance(name).SpentRunewords++
+			stats.RunesSpent++
+		}
+	}
+	for _, transmute := range a.transmuteHistory {
+		for _, in := range transmute.Inputs {
+			if strings.HasSuffix(in.Name, " Rune") {
+				balance(in.Name).SpentCube++
+				stats.RunesSpent++
+			}
+		}
+	}
+
+	for _, b := range balances {
+		b.Balance = b.Found - b.SpentRunewords - b.SpentCube
+		stats.Runes = append(stats.Runes, *b)
+	}
+	sort.Slice(stats.Runes, func(i, j int) bool {
+		return runeRank(stats.Runes[i].Rune) > runeRank(stats.Runes[j].Rune)
+	})
+	return stats
+}
+
+// ========== RUNEWORD HELPERS ==========
+
+var runeOrder = []string{
+	"El", "Eld", "Tir", "Nef", "Eth", "Ith", "Tal", "Ral", "Ort", "Thul", "Amn", "Sol", "Shael",
+	"Dol", "Hel", "Io", "Lum", "Ko", "Fal", "Lem", "Pul", "Um", "Mal", "Ist", "Gul", "Vex",
+	"Ohm", "Lo", "Sur", "Ber", "Jah", "Cham", "Zod",
+}
+
+// runeRank orders runes from El (1) to Zod (33); unknown names rank 0
+func runeRank(name string) int {
+	name = strings.TrimSuffix(name, " Rune")
+	for i, r := range runeOrder {
+		if strings.EqualFold(r, name) {
+			return i + 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,162 @@
+// runs.go - Run Records & Run Type Detection for D2R Tracker
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ========== RUN RECORD STRUCTURES ==========
+type RunRecord struct {
+	Index      int                `json:"index"`                 // Run number (matches ItemEntry.RunIndex)
+	Start      time.Time          `json:"start"`                 // When the run started
+	DurationMs int64              `json:"duration_ms"`           // Run duration in milliseconds
+	RunType    string             `json:"run_type"`              // Main farming area of the run
+	Areas      map[string]int64   `json:"areas,omitempty"`       // Milliseconds spent per area (towns excluded)
+	Gold       GoldBreakdown      `json:"gold"`                  // Gold income and expenses of the run
+	XP         int64              `json:"xp"`                    // Experience gained in the run
+	AreaXP     map[string]int64   `json:"area_xp,omitempty"`     // Experience gained per area
+	Character  *CharacterSnapshot `json:"character,omitempty"`   // Character sheet at the start of the run
+	Loadout    string             `json:"loadout,omitempty"`     // Loadout worn longest during the run
+	LoadoutTag string             `json:"loadout_tag,omitempty"` // Loadout set by the user, used instead of Loadout
+	Deaths     int                `json:"deaths,omitempty"`      // Player deaths in the run
+	MercDeaths int                `json:"merc_deaths,omitempty"` // Mercenary deaths in the run
+}
+
+// RunTypeDrops shows how often each kind of item dropped in one run type
+type RunTypeDrops struct {
+	RunType        string             `json:"run_type"`
+	Runs           int                `json:"runs"`
+	Drops          map[string]int     `json:"drops"`            // Kind (e.g. charm class, uber item) -> drops
+	PerHundredRuns map[string]float64 `json:"per_hundred_runs"` // Kind -> drops per 100 runs
+}
+
+// ========== RUN AREA TRACKING ==========
+
+func (a *App) updateRunAreaTracking() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now()
+	elapsed := now.Sub(a.lastAreaTick)
+	a.lastAreaTick = now
+
+	playerArea := a.lastGameData.PlayerUnit.Area
+	if !a.runActive || playerArea == 0 || playerArea.IsTown() {
+		return
+	}
+
+	// Ignore long gaps (loading screens, stalled reads)
+	if elapsed > time.Second {
+		elapsed = time.Second
+	}
+
+	a.runAreaTime[a.getAreaName(playerArea)] += elapsed
+}
+
+// getCurrentRunType returns the area the player spent the most time in during the active run
+func (a *App) getCurrentRunType() string {
+	runType := ""
+	var longest time.Duration
+	for areaName, spent := range a.runAreaTime {
+		if spent > longest || (spent == longest && areaName < runType) {
+			runType = areaName
+			longest = spent
+		}
+	}
+
+	if runType == "" {
+		return "Town"
+	}
+	return runType
+}
+
+// finishRunRecord stores the completed run and tags its items with the run type.
+// Must be called with a.mu held.
+func (a *App) finishRunRecord(duration time.Duration) {
+	runType := a.getCurrentRunType()
+
+	areas := make(map[string]int64, len(a.runAreaTime))
+	for areaName, spent := range a.runAreaTime {
+		areas[areaName] = spent.Milliseconds()
+	}
+
+	deaths, mercDeaths := a.countRunDeaths(a.currentRun)
+	a.runRecords = append(a.runRecords, RunRecord{
+		Index:      a.currentRun,
+		Start:      a.runStart,
+		DurationMs: duration.Milliseconds(),
+		RunType:    runType,
+		Areas:      areas,
+		Gold:       a.runGold,
+		XP:         a.xpTracking.XPThisRun,
+		AreaXP:     a.runAreaXP,
+		Character:  a.runSnapshot,
+		Loadout:    a.getRunLoadout(),
+		LoadoutTag: a.runLoadoutTag,
+		Deaths:     deaths,
+		MercDeaths: mercDeaths,
+	})
+	a.runLoadoutTag = ""
+
+	for i := range a.itemHistory {
+		if a.itemHistory[i].RunIndex == a.currentRun && a.itemHistory[i].RunType == "" {
+			a.itemHistory[i].RunType = runType
+		}
+	}
+
+	fmt.Printf("🗺️ Run #%d type: %s, net gold: %d, deaths: %d (merc: %d)\n", a.currentRun, runType, a.runGold.Net(), deaths, mercDeaths)
+}
+
+// ========== RUN TYPE DROP RATES ==========
+
+// dropsPerRunType counts items per run type under the kind returned by countFn ("" skips the item).
+// The most played run type comes first. Must be called with a.mu held.
+func (a *App) dropsPerRunType(countFn func(entry ItemEntry) string) []RunTypeDrops {
+	byType := make(map[string]*RunTypeDrops)
+	for _, record := range a.runRecords {
+		runType, found := byType[record.RunType]
+		if !found {
+			runType = &RunTypeDrops{RunType: record.RunType, Drops: make(map[string]int), PerHundredRuns: make(map[string]float64)}
+			byType[record.RunType] = runType
+		}
+		runType.Runs++
+	}
+
+	for _, entry := range a.itemHistory {
+		runType, found := byType[entry.RunType]
+		if !found {
+			continue
+		}
+		if kind := countFn(entry); kind != "" {
+			runType.Drops[kind]++
+		}
+	}
+
+	runTypes := make([]RunTypeDrops, 0, len(byType))
+	for _, runType := range byType {
+		for kind, drops := range runType.Drops {
+			runType.PerHundredRuns[kind] = float64(drops) / float64(runType.Runs) * 100
+		}
+		runTypes = append(runTypes, *runType)
+	}
+	sort.Slice(runTypes, func(i, j int) bool {
+		if runTypes[i].Runs != runTypes[j].Runs {
+			return runTypes[i].Runs > runTypes[j].Runs
+		}
+		return runTypes[i].RunType < runTypes[j].RunType
+	})
+	return runTypes
+}
+
+// ========== RUN API ==========
+
+func (a *App) GetRunHistory() []RunRecord {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	runs := make([]RunRecord, len(a.runRecords))
+	copy(runs, a.runRecords)
+	return runs
+}
@@ -0,0 +1,254 @@
+// shopping.go - Vendor Shopping Scanner for D2R Tracker
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+const maxShoppingMatches = 500
+
+// ========== SHOPPING STRUCTURES ==========
+
+// ShoppingRule describes a vendor item worth buying; every set field must match
+type ShoppingRule struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"` // Shown in alerts, e.g. "3os Phase Blade"
+	Enabled    bool       `json:"enabled"`
+	Vendor     string     `json:"vendor"`      // Vendor name, e.g. "Anya" ("" = any)
+	Item       string     `json:"item"`        // Part of the item name or type, e.g. "Phase Blade", "Claw" ("" = any)
+	Quality    string     `json:"quality"`     // Exact quality ("" = any)
+	MinSockets int        `json:"min_sockets"` // Minimum number of sockets (0 = any)
+	Ethereal   *bool      `json:"ethereal"`    // nil = ethereal and non-ethereal
+	Stats      []StatRule `json:"stats"`       // Required stats
+}
+
+// StatRule matches an item stat by its description, e.g. {"Lightning Skills", 3}
+type StatRule struct {
+	Text string `json:"text"` // Part of the stat description
+	Min  int    `json:"min"`  // Minimum stat value
+}
+
+type ShoppingMatch struct {
+	Time     time.Time `json:"time"`
+	RuleID   string    `json:"rule_id"`
+	Rule     string    `json:"rule"`
+	Vendor   string    `json:"vendor"`
+	Item     string    `json:"item"`
+	Quality  string    `json:"quality"`
+	Stats    []string  `json:"stats"` // Readable item stats
+	RunIndex int       `json:"run_index"`
+}
+
+// ========== VENDOR SCANNING ==========
+
+func (a *App) updateShoppingScanner() {
+	a.mu.Lock()
+
+	gameData := a.lastGameData
+	if a.wasInMenu || !gameData.OpenMenus.NPCShop || len(a.shoppingRules) == 0 {
+		a.mu.Unlock()
+		return
+	}
+
+	vendor := "Unknown"
+	if vendorID, found := getActiveVendor(gameData); found {
+		vendor = vendorNames[vendorID]
+	}
+
+	var matches []ShoppingMatch
+	for _, itm := range getVendorItems(gameData) {
+		if a.shoppingSeen[itm.UnitID] {
+			continue
+		}
+		a.shoppingSeen[itm.UnitID] = true
+
+		itemName := a.getItemName(itm)
+		quality := a.getItemQuality(itm)
+		for _, rule := range a.shoppingRules {
+			if !rule.Enabled || !matchesShoppingRule(rule, itm, itemName, quality, vendor) {
+				continue
+			}
+			match := ShoppingMatch{
+				Time:     time.Now(),
+				RuleID:   rule.ID,
+				Rule:     rule.Name,
+				Vendor:   vendor,
+				Item:     itemName,
+				Quality:  quality,
+				Stats:    describeItemStats(itm),
+				RunIndex: a.currentRun,
+			}
+			matches = append(matches, match)
+			break // One alert per item
+		}
+	}
+
+	a.shoppingMatches = append(a.shoppingMatches, matches...)
+	if len(a.shoppingMatches) > maxShoppingMatches {
+		a.shoppingMatches = a.shoppingMatches[len(a.shoppingMatches)-maxShoppingMatches:]
+	}
+	a.mu.Unlock()
+
+	for _, match := range matches {
+		fmt.Printf("🛒 SHOPPING MATCH at %s: %s (%s) - rule '%s'\n", match.Vendor, match.Item, match.Quality, match.Rule)
+		if a.ctx != nil {
+			runtime.EventsEmit(a.ctx, "shopping-match", match)
+		}
+	}
+}
+
+// resetShoppingScanner forgets seen vendor items; unit IDs are only valid within one game.
+// Must be called with a.mu held.
+func (a *App) resetShoppingScanner() {
+	a.shoppingSeen = make(map[data.UnitID]bool)
+}
+
+func matchesShoppingRule(rule ShoppingRule, itm data.Item, itemName string, quality string, vendor string) bool {
+	if rule.Vendor != "" && !strings.EqualFold(rule.Vendor, vendor) {
+		return false
+	}
+	if rule.Item != "" {
+		wanted := strings.ToLower(rule.Item)
+		itemType := itm.Type()
+		if !strings.Contains(strings.ToLower(itemName), wanted) &&
+			!strings.Contains(strings.ToLower(itemType.Name), wanted) &&
+			!strings.EqualFold(itemType.Code, rule.Item) {
+			return false
+		}
+	}
+	if rule.Quality != "" && normalizeItemQuality(rule.Quality) != quality {
+		return false
+	}
+	if rule.Ethereal != nil && itm.Ethereal != *rule.Ethereal {
+		return false
+	}
+	if rule.MinSockets > 0 {
+		if itemSockets(itm) < rule.MinSockets {
+			return false
+		}
+	}
+	for _, statRule := range rule.Stats {
+		if !hasMatchingStat(itm, statRule) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasMatchingStat(itm data.Item, statRule StatRule) bool {
+	wanted := strings.ToLower(strings.TrimSpace(statRule.Text))
+	for _, stats := range []stat.Stats{itm.Stats, itm.BaseStats} {
+		for _, s := range stats {
+			description := strings.ToLower(stat.StatStringMap[int(s.ID)][s.Layer])
+			if description != "" && strings.Contains(description, wanted) && s.Value >= statRule.Min {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// describeItemStats turns item stats into readable lines like "+3 to Lightning Skills (Sorceress only)"
+func describeItemStats(itm data.Item) []string {
+	lines := make([]string, 0, len(itm.Stats))
+	for _, s := range itm.Stats {
+		if stat.StatStringMap[int(s.ID)][s.Layer] == "" {
+			continue
+		}
+		lines = append(lines, s.String())
+	}
+	return lines
+}
+
+// ========== SHOPPING API ==========
+
+func (a *App) GetShoppingRules() []ShoppingRule {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	rules := make([]ShoppingRule, len(a.shoppingRules))
+	copy(rules, a.shoppingRules)
+	return rules
+}
+
+// SaveShoppingRule adds a rule (empty ID) or replaces the rule with the same ID
+func (a *App) SaveShoppingRule(rule ShoppingRule) (ShoppingRule, error) {
+	rule.Name = strings.TrimSpace(rule.Name)
+	if rule.Name == "" {
+		return ShoppingRule{}, fmt.Errorf("rule name cannot be empty")
+	}
+	if rule.Item == "" && rule.Quality == "" && rule.MinSockets == 0 && len(rule.Stats) == 0 {
+		return ShoppingRule{}, fmt.Errorf("rule '%s' would match every vendor item", rule.Name)
+	}
+	if rule.Quality != "" && normalizeItemQuality(rule.Quality) == "" {
+		return ShoppingRule{}, fmt.Errorf("unknown quality: %s", rule.Quality)
+	}
+	for _, statRule := range rule.Stats {
+		if strings.TrimSpace(statRule.Text) == "" {
+			return ShoppingRule{}, fmt.Errorf("stat text cannot be empty")
+		}
+	}
+
+	a.mu.Lock()
+	if rule.ID == "" {
+		rule.ID = newItemID()
+		a.shoppingRules = append(a.shoppingRules, rule)
+	} else {
+		found := false
+		for i := range a.shoppingRules {
+			if a.shoppingRules[i].ID == rule.ID {
+				a.shoppingRules[i] = rule
+				found = true
+				break
+			}
+		}
+		if !found {
+			a.mu.Unlock()
+			return ShoppingRule{}, fmt.Errorf("shopping rule not found: %s", rule.ID)
+		}
+	}
+	// Re-check items already seen in this game against the changed rules
+	a.resetShoppingScanner()
+	a.mu.Unlock()
+
+	fmt.Printf("🛒 Shopping rule saved: %s\n", rule.Name)
+	a.saveAfterItemEdit()
+	return rule, nil
+}
+
+func (a *App) DeleteShoppingRule(ruleID string) error {
+	a.mu.Lock()
+	for i := range a.shoppingRules {
+		if a.shoppingRules[i].ID == ruleID {
+			a.shoppingRules = append(a.shoppingRules[:i], a.shoppingRules[i+1:]...)
+			a.mu.Unlock()
+
+			fmt.Printf("🛒 Shopping rule deleted: %s\n", ruleID)
+			a.saveAfterItemEdit()
+			return nil
+		}
+	}
+	a.mu.Unlock()
+	return fmt.Errorf("shopping rule not found: %s", ruleID)
+}
+
+// GetShoppingMatches returns the newest matches first; limit <= 0 returns all
+func (a *App) GetShoppingMatches(limit int) []ShoppingMatch {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	matches := make([]ShoppingMatch, 0, len(a.shoppingMatches))
+	for i := len(a.shoppingMatches) - 1; i >= 0; i-- {
+		if limit > 0 && len(matches) >= limit {
+			break
+		}
+		matches = append(matches, a.shoppingMatches[i])
+	}
+	return matches
+}
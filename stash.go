@@ -0,0 +1,423 @@
+// stash.go - Stash & Shared Stash Snapshots for D2R Tracker
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+)
+
+const (
+	stashSnapshotInterval = 30 * time.Second
+	maxStashChanges       = 2000
+	// Consecutive empty snapshots before an emptied stash is believed without seeing it open
+	stashEmptyReadsRequired = 3
+)
+
+// ========== STASH STRUCTURES ==========
+type StashItem struct {
+	Name         string `json:"name"`
+	Quality      string `json:"quality"`
+	Affixes      string `json:"affixes,omitempty"`
+	Location     string `json:"location"` // "stash" or "shared_stash"
+	Page         int    `json:"page"`     // Shared stash page
+	X            int    `json:"x"`
+	Y            int    `json:"y"`
+	IsEthereal   bool   `json:"is_ethereal,omitempty"`
+	IsIdentified bool   `json:"is_identified,omitempty"`
+	ItemLevel    int    `json:"item_level,omitempty"`
+}
+
+type StashChange struct {
+	Time   time.Time `json:"time"`
+	Action string    `json:"action"` // "added" or "removed"
+	Item   StashItem `json:"item"`
+}
+
+// StashData is persisted per character in profiles/stashes/<character>.json
+type StashData struct {
+	Character  string        `json:"character"`
+	Updated    time.Time     `json:"updated"`     // Time of the last stash change
+	Gold       int           `json:"gold"`        // Personal stash gold
+	SharedGold []int         `json:"shared_gold"` // Gold per shared stash page
+	Items      []StashItem   `json:"items"`       // Current stash contents
+	Changes    []StashChange `json:"changes"`     // Diff log, oldest first
+}
+
+type StashView struct {
+	Character  string      `json:"character"`
+	Updated    time.Time   `json:"updated"`
+	Gold       int         `json:"gold"`
+	SharedGold []int       `json:"shared_gold"`
+	TotalItems int         `json:"total_items"` // Items in the stash (before search)
+	Items      []StashItem `json:"items"`       // Matching items
+}
+
+// ========== STASH SNAPSHOT TRACKING ==========
+
+func (a *App) updateStashTracking() {
+	a.mu.Lock()
+	if a.wasInMenu || a.lastGameData.PlayerUnit.Area == 0 {
+		a.mu.Unlock()
+		return
+	}
+
+	// Snapshot on a timer and right after the stash was closed
+	stashOpen := a.lastGameData.OpenMenus.Stash
+	stashClosed := a.stashWasOpen && !stashOpen
+	a.stashWasOpen = stashOpen
+	if !stashClosed && time.Since(a.lastStashSnapshot) < stashSnapshotInterval {
+		a.mu.Unlock()
+		return
+	}
+	a.lastStashSnapshot = time.Now()
+
+	character := a.lastGameData.PlayerUnit.Name
+	if character == "" {
+		a.mu.Unlock()
+		return
+	}
+	if a.stashData.Character != character {
+		a.stashData = loadStashData(a.getStashFilePath(character), character)
+		a.stashSignature = nil
+		a.stashEmptyReads = 0
+	}
+
+	stashItems := getStashItems(a.lastGameData)
+	signature := stashSignature(stashItems)
+	if a.stashSignature != nil && sameSignature(signature, a.stashSignature) {
+		a.mu.Unlock()
+		return
+	}
+
+	// An empty read right after loading a game is more likely a failed read than an emptied stash.
+	// It is trusted once the stash window was open or the stash stays empty for a few snapshots.
+	if len(stashItems) == 0 && len(a.stashData.Items) > 0 && a.stashSignature == nil {
+		a.stashEmptyReads++
+		if !stashOpen && !stashClosed && a.stashEmptyReads < stashEmptyReadsRequired {
+			a.mu.Unlock()
+			return
+		}
+	}
+	a.stashEmptyReads = 0
+	a.stashSignature = signature
+
+	snapshot := make([]StashItem, 0, len(stashItems))
+	for _, itm := range stashItems {
+		snapshot = append(snapshot, a.newStashItem(itm))
+	}
+	sortStashItems(snapshot)
+
+	changes := diffStashItems(a.stashData.Items, snapshot, time.Now())
+	gold, sharedGold := stashGold(a.lastGameData)
+	goldChanged := gold != a.stashData.Gold || !sameInts(sharedGold, a.stashData.SharedGold)
+	if len(changes) == 0 && !goldChanged && !a.stashData.Updated.IsZero() {
+		a.mu.Unlock()
+		return
+	}
+
+	a.stashData.Items = snapshot
+	a.stashData.Gold = gold
+	a.stashData.SharedGold = sharedGold
+	a.stashData.Updated = time.Now()
+	a.stashData.Changes = append(a.stashData.Changes, changes...)
+	if len(a.stashData.Changes) > maxStashChanges {
+		a.stashData.Changes = a.stashData.Changes[len(a.stashData.Changes)-maxStashChanges:]
+	}
+
+	stashCopy := a.stashData
+	stashCopy.Items = append([]StashItem(nil), a.stashData.Items...)
+	stashCopy.Changes = append([]StashChange(nil), a.stashData.Changes...)
+	filePath := a.getStashFilePath(character)
+	a.mu.Unlock()
+
+	fmt.Printf("🧰 Stash snapshot for %s: %d items, %d change(s)\n", character, len(snapshot), len(changes))
+	if err := saveStashData(filePath, stashCopy); err != nil {
+		fmt.Printf("⚠️ STASH SAVE WARNING: %v\n", err)
+	}
+}
+
+// ========== STASH API ==========
+
+// GetStashCharacters lists all characters with a saved stash snapshot
+func (a *App) GetStashCharacters() []string {
+	files, err := ioutil.ReadDir(a.getStashDir())
+	if err != nil {
+		return []string{}
+	}
+
+	characters := make([]string, 0, len(files))
+	for _, file := range files {
+		if !file.IsDir() && strings.HasSuffix(file.Name(), ".json") {
+			characters = append(characters, strings.TrimSuffix(file.Name(), ".json"))
+		}
+	}
+	sort.Strings(characters)
+	return characters
+}
+
+// SearchStash returns the saved stash contents of a character, also while the game is closed.
+// character "" = current character, location "" = personal and shared stash.
+func (a *App) SearchStash(character string, search string, location string) (StashView, error) {
+	stash, err := a.getStashForCharacter(character)
+	if err != nil {
+		return StashView{}, err
+	}
+
+	terms := strings.Fields(strings.ToLower(search))
+	view := StashView{
+		Character:  stash.Character,
+		Updated:    stash.Updated,
+		Gold:       stash.Gold,
+		SharedGold: stash.SharedGold,
+		TotalItems: len(stash.Items),
+		Items:      []StashItem{},
+	}
+	for _, stashItem := range stash.Items {
+		if location != "" && stashItem.Location != location {
+			continue
+		}
+		if !matchesStashSearch(stashItem, terms) {
+			continue
+		}
+		view.Items = append(view.Items, stashItem)
+	}
+	return view, nil
+}
+
+// GetStashChanges returns the newest stash changes first; limit <= 0 returns all
+func (a *App) GetStashChanges(character string, limit int) ([]StashChange, error) {
+	stash, err := a.getStashForCharacter(character)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]StashChange, 0, len(stash.Changes))
+	for i := len(stash.Changes) - 1; i >= 0; i-- {
+		if limit > 0 && len(changes) >= limit {
+			break
+		}
+		changes = append(changes, stash.Changes[i])
+	}
+	return changes, nil
+}
+
+// ========== STASH HELPERS ==========
+
+func (a *App) getStashDir() string {
+	return filepath.Join(a.profilesDir, "stashes")
+}
+
+func (a *App) getStashFilePath(character string) string {
+	return filepath.Join(a.getStashDir(), sanitizeFileName(character)+".json")
+}
+
+func (a *App) getStashForCharacter(character string) (StashData, error) {
+	a.mu.RLock()
+	current := a.stashData
+	if character == "" {
+		character = current.Character
+	}
+	a.mu.RUnlock()
+
+	if character == "" {
+		return StashData{}, fmt.Errorf("no character selected")
+	}
+	if character == current.Character {
+		return current, nil
+	}
+
+	filePath := a.getStashFilePath(character)
+	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		return StashData{}, fmt.Errorf("no stash snapshot for character: %s", character)
+	}
+	return loadStashData(filePath, character), nil
+}
+
+func loadStashData(filePath string, character string) StashData {
+	stash := StashData{Character: character}
+
+	raw, err := ioutil.ReadFile(filePath)
+	if err == nil {
+		if err := json.Unmarshal(raw, &stash); err != nil {
+			fmt.Printf("⚠️ Could not parse stash file %s: %v\n", filePath, err)
+			stash = StashData{Character: character}
+		}
+	}
+
+	stash.Character = character
+	if stash.Items == nil {
+		stash.Items = make([]StashItem, 0)
+	}
+	if stash.Changes == nil {
+		stash.Changes = make([]StashChange, 0)
+	}
+	return stash
+}
+
+func saveStashData(filePath string, stash StashData) error {
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return fmt.Errorf("failed to create stash directory: %v", err)
+	}
+	raw, err := json.MarshalIndent(stash, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode stash: %v", err)
+	}
+	if err := ioutil.WriteFile(filePath, raw, 0644); err != nil {
+		return fmt.Errorf("failed to write stash file: %v", err)
+	}
+	return nil
+}
+
+func getStashItems(gameData data.Data) []data.Item {
+	var stashItems []data.Item
+	for _, itm := range gameData.Inventory.AllItems {
+		if itm.Name == "" {
+			continue
+		}
+		if itm.Location.LocationType == item.LocationStash || itm.Location.LocationType == item.LocationSharedStash {
+			stashItems = append(stashItems, itm)
+		}
+	}
+	return stashItems
+}
+
+func stashGold(gameData data.Data) (int, []int) {
+	gold := gameData.Inventory.StashedGold[0]
+	sharedGold := make([]int, 0, len(gameData.Inventory.StashedGold)-1)
+	sharedGold = append(sharedGold, gameData.Inventory.StashedGold[1:]...)
+	return gold, sharedGold
+}
+
+// newStashItem converts a game item. Must be called with a.mu held.
+func (a *App) newStashItem(itm data.Item) StashItem {
+	return StashItem{
+		Name:         a.getItemName(itm),
+		Quality:      a.getItemQuality(itm),
+		Affixes:      a.getItemAffixes(itm),
+		Location:     string(itm.Location.LocationType),
+		Page:         itm.Location.Page,
+		X:            itm.Position.X,
+		Y:            itm.Position.Y,
+		IsEthereal:   itm.Ethereal,
+		IsIdentified: itm.Identified,
+		ItemLevel:    itm.LevelReq,
+	}
+}
+
+// stashSignature cheaply fingerprints the raw stash so unchanged stashes skip name lookups
+func stashSignature(stashItems []data.Item) map[string]int {
+	signature := make(map[string]int, len(stashItems))
+	for _, itm := range stashItems {
+		signature[fmt.Sprintf("%s_%v_%d_%d_%d_%d_%t_%t", itm.Name, itm.Location.LocationType, itm.Location.Page,
+			itm.Position.X, itm.Position.Y, itm.Quality, itm.Ethereal, itm.Identified)]++
+	}
+	return signature
+}
+
+func sameSignature(x, y map[string]int) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for key, count := range x {
+		if y[key] != count {
+			return false
+		}
+	}
+	return true
+}
+
+// diffStashItems compares stash contents by item identity, so moving an item is not a change
+func diffStashItems(before, after []StashItem, now time.Time) []StashChange {
+	counts := make(map[string]int)
+	examples := make(map[string]StashItem)
+	for _, stashItem := range before {
+		counts[stashItemIdentity(stashItem)]--
+		examples[stashItemIdentity(stashItem)] = stashItem
+	}
+	for _, stashItem := range after {
+		counts[stashItemIdentity(stashItem)]++
+		examples[stashItemIdentity(stashItem)] = stashItem
+	}
+
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var changes []StashChange
+	for _, key := range keys {
+		action := "added"
+		count := counts[key]
+		if count < 0 {
+			action, count = "removed", -count
+		}
+		for i := 0; i < count; i++ {
+			changes = append(changes, StashChange{Time: now, Action: action, Item: examples[key]})
+		}
+	}
+	return changes
+}
+
+func stashItemIdentity(stashItem StashItem) string {
+	return strings.Join([]string{
+		stashItem.Name, stashItem.Quality, stashItem.Affixes, fmt.Sprintf("%t", stashItem.IsEthereal),
+	}, "|")
+}
+
+func sortStashItems(stashItems []StashItem) {
+	sort.Slice(stashItems, func(i, j int) bool {
+		x, y := stashItems[i], stashItems[j]
+		if x.Location != y.Location {
+			return x.Location < y.Location
+		}
+		if x.Page != y.Page {
+			return x.Page < y.Page
+		}
+		if x.Y != y.Y {
+			return x.Y < y.Y
+		}
+		return x.X < y.X
+	})
+}
+
+func matchesStashSearch(stashItem StashItem, terms []string) bool {
+	text := strings.ToLower(strings.Join([]string{stashItem.Name, stashItem.Quality, stashItem.Affixes}, " "))
+	for _, term := range terms {
+		if !strings.Contains(text, term) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameInts(x, y []int) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// sanitizeFileName keeps character names usable as file names
+func sanitizeFileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`<>:"/\|?*`, r) || r < 32 {
+			return '_'
+		}
+		return r
+	}, name)
+}
@@ -0,0 +1,151 @@
+// ubers.go - Key, Organ & Essence Tracking for D2R Tracker
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+)
+
+// ========== UBER STRUCTURES ==========
+
+type uberItem struct {
+	raw    item.Name
+	group  string // keys, organs, essences or standard
+	source string // Where it drops
+}
+
+var uberItems = []uberItem{
+	{"KeyOfTerror", "keys", "The Countess"},
+	{"KeyOfHate", "keys", "The Summoner"},
+	{"KeyOfDestruction", "keys", "Nihlathak"},
+	{"MephistosBrain", "organs", "Lilith"},
+	{"DiablosHorn", "organs", "Uber Duriel"},
+	{"BaalsEye", "organs", "Uber Izual"},
+	{"StandardOfHeroes", "standard", "Nihlathak (Hell) and Uber Diablo"},
+	{"TwistedEssenceOfSuffering", "essences", "Andariel and Duriel"},
+	{"ChargedEssenceOfHatred", "essences", "Mephisto"},
+	{"BurningEssenceOfTerror", "essences", "Diablo"},
+	{"FesteringEssenceOfDestruction", "essences", "Baal"},
+}
+
+type UberStats struct {
+	Items     []UberItemCount `json:"items"`
+	KeySets   int             `json:"key_sets"`   // Complete Terror + Hate + Destruction sets owned
+	OrganSets int             `json:"organ_sets"` // Complete Brain + Horn + Eye sets owned
+	TokenSets int             `json:"token_sets"` // Complete sets of the four essences owned
+	Progress  string          `json:"progress"`   // e.g. "3 complete key sets, 2 organ sets, 0 token sets"
+	Character string          `json:"character"`  // Character whose inventory and stash were counted
+	RunTypes  []RunTypeDrops  `json:"run_types"`  // Drops per item name
+}
+
+type UberItemCount struct {
+	Name   string `json:"name"`
+	Group  string `json:"group"`
+	Source string `json:"source"`
+	Found  int    `json:"found"` // Picked up (item history)
+	Owned  int    `json:"owned"` // Inventory, cube and stash while in game, else the last stash snapshot
+}
+
+// ========== UBER API ==========
+
+// GetUberStats counts keys, organs and essences from pickups and from the current character's items
+func (a *App) GetUberStats() UberStats {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	stats := UberStats{Items: make([]UberItemCount, 0, len(uberItems))}
+
+	names := make(map[string]int, len(uberItems)) // Display name -> index in stats.Items
+	raws := make(map[item.Name]int, len(uberItems))
+	for i, uber := range uberItems {
+		name := a.displayItemName(uber.raw)
+		stats.Items = append(stats.Items, UberItemCount{Name: name, Group: uber.group, Source: uber.source})
+		names[name] = i
+		raws[uber.raw] = i
+	}
+
+	// Pickups, matched by raw name when known so renamed items still count
+	matchPickup := func(entry ItemEntry) (int, bool) {
+		if entry.TrackKey != "" {
+			idx, found := raws[item.Name(strings.SplitN(entry.TrackKey, "|", 2)[0])]
+			return idx, found
+		}
+		idx, found := names[entry.OriginalName]
+		return idx, found
+	}
+	for _, entry := range a.itemHistory {
+		if idx, found := matchPickup(entry); found {
+			stats.Items[idx].Found++
+		}
+	}
+	stats.RunTypes = a.dropsPerRunType(func(entry ItemEntry) string {
+		if idx, found := matchPickup(entry); found {
+			return stats.Items[idx].Name
+		}
+		return ""
+	})
+
+	// Owned: live items while in game, otherwise the last stash snapshot
+	if !a.wasInMenu && a.lastGameData.PlayerUnit.Area != 0 {
+		stats.Character = a.lastGameData.PlayerUnit.Name
+		for _, itm := range a.lastGameData.Inventory.AllItems {
+			switch itm.Location.LocationType {
+			case item.LocationInventory, item.LocationCube, item.LocationCursor, item.LocationStash, item.LocationSharedStash:
+			default:
+				continue
+			}
+			if idx, found := raws[itm.Name]; found {
+				stats.Items[idx].Owned++
+			}
+		}
+	} else {
+		stats.Character = a.stashData.Character
+		for _, stashItem := range a.stashData.Items {
+			if idx, found := names[stashItem.Name]; found {
+				stats.Items[idx].Owned++
+			}
+		}
+	}
+
+	stats.KeySets = completeSets(stats.Items, "keys")
+	stats.OrganSets = completeSets(stats.Items, "organs")
+	stats.TokenSets = completeSets(stats.Items, "essences")
+	stats.Progress = formatSetProgress(stats.KeySets, stats.OrganSets, stats.TokenSets)
+	return stats
+}
+
+// ========== UBER HELPERS ==========
+
+// displayItemName is the display name getItemName would give, without its logging
+func (a *App) displayItemName(raw item.Name) string {
+	if displayName, found := a.itemNameMapping[string(raw)]; found {
+		return displayName
+	}
+	return a.beautifyItemName(string(raw))
+}
+
+// completeSets is the lowest owned count within a group
+func completeSets(counts []UberItemCount, group string) int {
+	sets := -1
+	for _, count := range counts {
+		if count.Group == group && (sets < 0 || count.Owned < sets) {
+			sets = count.Owned
+		}
+	}
+	if sets < 0 {
+		return 0
+	}
+	return sets
+}
+
+func formatSetProgress(keySets, organSets, tokenSets int) string {
+	sets := func(n int, what string) string {
+		if n == 1 {
+			return fmt.Sprintf("1 complete %s set", what)
+		}
+		return fmt.Sprintf("%d complete %s sets", n, what)
+	}
+	return sets(keySets, "key") + ", " + sets(organSets, "organ") + ", " + sets(tokenSets, "token")
+}
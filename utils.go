@@ -2,6 +2,8 @@
 package main
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -61,6 +63,14 @@ func formatDuration(ms int64) string {
 	return d.Truncate(time.Second).String()
 }
 
+// cleanCSVField keeps semicolons, quotes and line breaks out of exported CSV values
+func cleanCSVField(value string) string {
+	value = strings.ReplaceAll(value, ";", ",")  // Replace semicolons with commas
+	value = strings.ReplaceAll(value, "\"", "'")  // Replace quotes with apostrophes
+	value = strings.ReplaceAll(value, "\r", " ")
+	return strings.ReplaceAll(value, "\n", " ")
+}
+
 func (a *App) getRunStats() (fastest, slowest, average int64) {
 	if len(a.runTimes) == 0 {
 		return 0, 0, 0
@@ -87,8 +97,8 @@ func (a *App) getRunStats() (fastest, slowest, average int64) {
 // ========== PLAYER DATA UTILITIES ==========
 
 func (a *App) getPlayerClassName() string {
-	// Use cached game data if available
-	if a.lastGameData.PlayerUnit.Class > 0 {
+	// Use cached game data if available (Amazon is class 0, so check the name)
+	if a.lastGameData.PlayerUnit.Name != "" {
 		switch a.lastGameData.PlayerUnit.Class {
 		case 0:
 			return "Amazon"
@@ -210,8 +220,23 @@ func (a *App) beautifyItemName(name string) string {
 	return result.String()
 }
 
+// itemQualities lists every quality name getItemQuality can return, in display order
+var itemQualities = []string{
+	"Low Quality", "Normal", "Superior", "Magic", "Rare", "Set", "Unique", "Crafted", "Runeword", "Quest",
+}
+
 func (a *App) getItemQuality(itm data.Item) string {
+	// Runewords and quest items report Normal/Superior in memory, so check them first
+	if itm.IsRuneword {
+		return "Runeword"
+	}
+	if itm.IsFromQuest() {
+		return "Quest"
+	}
+
 	switch itm.Quality {
+	case item.QualityLowQuality:
+		return "Low Quality" // Cracked, Crude, Damaged, Low Quality
 	case item.QualityNormal:
 		return "Normal"
 	case item.QualitySuperior:
@@ -224,11 +249,24 @@ func (a *App) getItemQuality(itm data.Item) string {
 		return "Set"
 	case item.QualityUnique:
 		return "Unique"
+	case item.QualityCrafted:
+		return "Crafted"
 	default:
 		return "Unknown"
 	}
 }
 
+// normalizeItemQuality maps user input to a known quality name ("" if unknown)
+func normalizeItemQuality(quality string) string {
+	quality = strings.TrimSpace(quality)
+	for _, q := range itemQualities {
+		if strings.EqualFold(q, quality) || strings.EqualFold(strings.ReplaceAll(q, " ", ""), quality) {
+			return q
+		}
+	}
+	return ""
+}
+
 func (a *App) getItemKey(itm data.Item) string {
 	return fmt.Sprintf("%s_%v_%d_%d_%d",
 		itm.Name, itm.Location.LocationType, itm.Location.Page, itm.Position.X, itm.Position.Y)
@@ -265,6 +303,58 @@ func (a *App) initializeInventory(gameData data.Data) {
 	a.lastGroundItems = a.getGroundItems(gameData)
 }
 
+// ========== ITEM ID UTILITIES ==========
+
+// newItemID returns a random, persistable ID for a new ItemEntry
+func newItemID() string {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		// crypto/rand never fails on supported platforms; keep IDs unique anyway
+		return fmt.Sprintf("%016x", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(buf)
+}
+
+// ensureItemIDs assigns IDs to items from profiles saved before IDs existed
+func (a *App) ensureItemIDs() {
+	assigned := 0
+	for i := range a.itemHistory {
+		if a.itemHistory[i].ID == "" {
+			a.itemHistory[i].ID = newItemID()
+			assigned++
+		}
+		if a.itemHistory[i].Version == 0 {
+			a.itemHistory[i].Version = 1
+		}
+	}
+	if assigned > 0 {
+		fmt.Printf("🆔 Assigned IDs to %d items\n", assigned)
+	}
+}
+
+func (a *App) findItemIndex(itemID string) (int, bool) {
+	for i := range a.itemHistory {
+		if a.itemHistory[i].ID == itemID {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// getItemForUpdate resolves an item ID and checks the caller's version (optimistic concurrency)
+func (a *App) getItemForUpdate(itemID string, expectedVersion int) (int, error) {
+	itemIndex, found := a.findItemIndex(itemID)
+	if !found {
+		return -1, fmt.Errorf("item not found: %s", itemID)
+	}
+
+	if current := a.itemHistory[itemIndex].Version; current != expectedVersion {
+		return -1, fmt.Errorf("conflict: item '%s' was changed in the meantime (version %d, expected %d) - please reload",
+			a.itemHistory[itemIndex].Name, current, expectedVersion)
+	}
+	return itemIndex, nil
+}
+
 // ========== ITEM TRACKING UTILITIES ==========
 
 func (a *App) trackItemsFromGround(currentGroundItems map[string]data.Item) {
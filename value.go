@@ -0,0 +1,333 @@
+// value.go - Item Value Estimation for D2R Tracker
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ========== PRICE TABLE STRUCTURES ==========
+
+// PriceTable is the user-editable price_table.json; all values are in Currency (e.g. Ist rune equivalents)
+type PriceTable struct {
+	Currency string             `json:"currency"`
+	Uniques  map[string]float64 `json:"uniques"` // Unique name -> value
+	Sets     map[string]float64 `json:"sets"`    // Set item name -> value
+	Runes    map[string]float64 `json:"runes"`   // Rune name -> value
+	Bases    map[string]float64 `json:"bases"`   // Base name -> value (Normal/Superior items only)
+}
+
+type ValueStats struct {
+	Currency        string         `json:"currency"`
+	TotalValue      float64        `json:"totalValue"`      // Value of all tracked items
+	CurrentRunValue float64        `json:"currentRunValue"` // Value found in the active run
+	ValuePerRun     float64        `json:"valuePerRun"`     // Average over completed runs
+	ValuePerHour    float64        `json:"valuePerHour"`    // Based on completed run time
+	RunTypes        []RunTypeValue `json:"runTypes"`        // Best paying run types first
+}
+
+type RunTypeValue struct {
+	RunType      string  `json:"runType"`
+	Runs         int     `json:"runs"`
+	TotalValue   float64 `json:"totalValue"`
+	ValuePerRun  float64 `json:"valuePerRun"`
+	ValuePerHour float64 `json:"valuePerHour"`
+}
+
+// ========== LOAD PRICE TABLE ==========
+// loadPriceTable replaces the price table only when price_table.json was read and parsed
+func (a *App) loadPriceTable() error {
+	exePath, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("could not get executable path: %v", err)
+	}
+
+	priceTablePath := filepath.Join(filepath.Dir(exePath), "price_table.json")
+	if a.priceTablePath == "" {
+		a.priceTablePath = priceTablePath // New prices are saved next to the executable
+	}
+
+	// Fallback: try current working directory
+	if _, err := os.Stat(priceTablePath); os.IsNotExist(err) {
+		priceTablePath = "price_table.json"
+	}
+
+	// Check if file exists
+	if _, err := os.Stat(priceTablePath); os.IsNotExist(err) {
+		fmt.Printf("⚠️ price_table.json not found at: %s\n", priceTablePath)
+		return fmt.Errorf("price_table.json not found")
+	}
+
+	// Read file
+	data, err := ioutil.ReadFile(priceTablePath)
+	if err != nil {
+		return fmt.Errorf("could not read price_table.json: %v", err)
+	}
+
+	var table PriceTable
+	if err := json.Unmarshal(data, &table); err != nil {
+		return fmt.Errorf("could not parse price_table.json: %v", err)
+	}
+
+	a.priceTablePath = priceTablePath
+	a.setPriceTable(table)
+
+	fmt.Printf("✅ Price table loaded: %d uniques, %d sets, %d runes, %d bases (%s)\n",
+		len(table.Uniques), len(table.Sets), len(table.Runes), len(table.Bases), a.priceTable.Currency)
+	return nil
+}
+
+// setPriceTable installs a price table and builds the case-insensitive lookup
+func (a *App) setPriceTable(table PriceTable) {
+	if table.Currency == "" {
+		table.Currency = "Ist"
+	}
+	if table.Uniques == nil {
+		table.Uniques = make(map[string]float64)
+	}
+	if table.Sets == nil {
+		table.Sets = make(map[string]float64)
+	}
+	if table.Runes == nil {
+		table.Runes = make(map[string]float64)
+	}
+	if table.Bases == nil {
+		table.Bases = make(map[string]float64)
+	}
+
+	a.priceTable = table
+	a.priceLookup = map[string]map[string]float64{
+		"uniques": lowerKeys(table.Uniques),
+		"sets":    lowerKeys(table.Sets),
+		"runes":   lowerKeys(table.Runes),
+		"bases":   lowerKeys(table.Bases),
+	}
+}
+
+// ========== PRICE TABLE API ==========
+
+func (a *App) GetPriceTable() PriceTable {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return a.copyPriceTable()
+}
+
+// SetItemPrice sets the value of one price table entry; a value <= 0 removes the entry.
+// category is "uniques", "sets", "runes" or "bases".
+func (a *App) SetItemPrice(category string, name string, value float64) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("item name cannot be empty")
+	}
+
+	a.mu.Lock()
+	table := a.copyPriceTable()
+	var prices map[string]float64
+	switch strings.ToLower(category) {
+	case "uniques":
+		prices = table.Uniques
+	case "sets":
+		prices = table.Sets
+	case "runes":
+		prices = table.Runes
+	case "bases":
+		prices = table.Bases
+	default:
+		a.mu.Unlock()
+		return fmt.Errorf("unknown price category: %s", category)
+	}
+
+	// Replace existing entries regardless of spelling
+	for key := range prices {
+		if strings.EqualFold(key, name) {
+			delete(prices, key)
+		}
+	}
+	if value > 0 {
+		prices[name] = value
+	}
+
+	a.setPriceTable(table)
+	a.recalculateItemValues()
+	priceTablePath := a.priceTablePath
+	a.mu.Unlock()
+
+	if err := a.savePriceTable(table, priceTablePath); err != nil {
+		return err
+	}
+
+	fmt.Printf("💰 Price set: %s/%s = %.2f %s\n", category, name, value, table.Currency)
+	a.saveAfterItemEdit()
+	return nil
+}
+
+// ReloadPriceTable re-reads price_table.json after it was edited by hand.
+// On error the current prices and item values are kept.
+func (a *App) ReloadPriceTable() error {
+	a.mu.Lock()
+	err := a.loadPriceTable()
+	if err == nil {
+		a.recalculateItemValues()
+	}
+	a.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+	a.saveAfterItemEdit()
+	return nil
+}
+
+// copyPriceTable returns a deep copy of the price table. Must be called with a.mu held.
+func (a *App) copyPriceTable() PriceTable {
+	return PriceTable{
+		Currency: a.priceTable.Currency,
+		Uniques:  copyPrices(a.priceTable.Uniques),
+		Sets:     copyPrices(a.priceTable.Sets),
+		Runes:    copyPrices(a.priceTable.Runes),
+		Bases:    copyPrices(a.priceTable.Bases),
+	}
+}
+
+func (a *App) savePriceTable(table PriceTable, path string) error {
+	data, err := json.MarshalIndent(table, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode price table: %v", err)
+	}
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("could not write %s: %v", path, err)
+	}
+	return nil
+}
+
+// ========== ITEM VALUE ESTIMATION ==========
+
+// estimateItemValue looks up an item in the price table. Must be called with a.mu held.
+func (a *App) estimateItemValue(item ItemEntry) float64 {
+	switch item.Quality {
+	case "Unique":
+		return a.lookupPrice("uniques", namedItemName(item.Affixes, "Unique: "), item.Name)
+	case "Set":
+		return a.lookupPrice("sets", namedItemName(item.Affixes, "Set: "), item.Name)
+	case "Normal", "Superior":
+		if value := a.lookupPrice("runes", item.OriginalName, item.Name); value > 0 {
+			return value
+		}
+		return a.lookupPrice("bases", item.OriginalName, item.Name)
+	}
+	return 0
+}
+
+// recalculateItemValues refreshes the value of every item. Must be called with a.mu held.
+func (a *App) recalculateItemValues() {
+	for i := range a.itemHistory {
+		a.itemHistory[i].Value = a.estimateItemValue(a.itemHistory[i])
+	}
+}
+
+// lookupPrice returns the value of the first name found in a price category
+func (a *App) lookupPrice(category string, names ...string) float64 {
+	prices := a.priceLookup[category]
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+		if value, found := prices[strings.ToLower(strings.TrimSpace(name))]; found {
+			return value
+		}
+	}
+	return 0
+}
+
+// namedItemName extracts "Harlequin Crest" from affixes like "Unique: Harlequin Crest • Req Level 62"
+func namedItemName(affixes string, prefix string) string {
+	for _, part := range strings.Split(affixes, " • ") {
+		if strings.HasPrefix(part, prefix) {
+			return strings.TrimPrefix(part, prefix)
+		}
+	}
+	return ""
+}
+
+// ========== VALUE STATISTICS ==========
+
+// getValueStats summarizes item value per run, per hour and per run type. Must be called with a.mu held.
+func (a *App) getValueStats() ValueStats {
+	stats := ValueStats{Currency: a.priceTable.Currency, RunTypes: []RunTypeValue{}}
+
+	runValues := make(map[int]float64)
+	for _, item := range a.itemHistory {
+		stats.TotalValue += item.Value
+		runValues[item.RunIndex] += item.Value
+	}
+	if a.runActive {
+		stats.CurrentRunValue = runValues[a.currentRun]
+	}
+
+	// Rates over all completed runs and per run type, both from the run records
+	var completedValue float64
+	var completedMs int64
+	byType := make(map[string]*RunTypeValue)
+	typeMs := make(map[string]int64)
+	for _, record := range a.runRecords {
+		completedValue += runValues[record.Index]
+		completedMs += record.DurationMs
+
+		entry, found := byType[record.RunType]
+		if !found {
+			entry = &RunTypeValue{RunType: record.RunType}
+			byType[record.RunType] = entry
+		}
+		entry.Runs++
+		entry.TotalValue += runValues[record.Index]
+		typeMs[record.RunType] += record.DurationMs
+	}
+	for runType, entry := range byType {
+		entry.ValuePerRun, entry.ValuePerHour = valueRates(entry.TotalValue, entry.Runs, typeMs[runType])
+		stats.RunTypes = append(stats.RunTypes, *entry)
+	}
+	stats.ValuePerRun, stats.ValuePerHour = valueRates(completedValue, len(a.runRecords), completedMs)
+
+	sort.Slice(stats.RunTypes, func(i, j int) bool {
+		if stats.RunTypes[i].ValuePerHour != stats.RunTypes[j].ValuePerHour {
+			return stats.RunTypes[i].ValuePerHour > stats.RunTypes[j].ValuePerHour
+		}
+		return stats.RunTypes[i].RunType < stats.RunTypes[j].RunType
+	})
+
+	return stats
+}
+
+func valueRates(value float64, runs int, durationMs int64) (perRun float64, perHour float64) {
+	if runs > 0 {
+		perRun = value / float64(runs)
+	}
+	if durationMs > 0 {
+		perHour = value / (float64(durationMs) / 3600000)
+	}
+	return perRun, perHour
+}
+
+// ========== PRICE HELPERS ==========
+
+func lowerKeys(prices map[string]float64) map[string]float64 {
+	lookup := make(map[string]float64, len(prices))
+	for name, value := range prices {
+		lookup[strings.ToLower(strings.TrimSpace(name))] = value
+	}
+	return lookup
+}
+
+func copyPrices(prices map[string]float64) map[string]float64 {
+	result := make(map[string]float64, len(prices))
+	for name, value := range prices {
+		result[name] = value
+	}
+	return result
+}
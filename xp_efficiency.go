@@ -0,0 +1,107 @@
+// xp_efficiency.go - XP Efficiency by Area & Run Type for D2R Tracker
+package main
+
+import (
+	"sort"
+	"time"
+)
+
+// ========== XP EFFICIENCY STRUCTURES ==========
+
+type XPEfficiency struct {
+	Areas    []XPAreaStats    `json:"areas"`     // Best XP per minute first
+	RunTypes []XPRunTypeStats `json:"run_types"` // Best XP per minute first
+}
+
+// XPAreaStats is the experience gained while the player stood in one area
+type XPAreaStats struct {
+	Area        string  `json:"area"`
+	Runs        int     `json:"runs"` // Runs that gained XP in the area
+	XP          int64   `json:"xp"`
+	Minutes     float64 `json:"minutes"`       // Time spent in the area (towns are not timed)
+	XPPerMinute float64 `json:"xp_per_minute"` // 0 when the time is unknown
+}
+
+// XPRunTypeStats compares whole runs, including town time
+type XPRunTypeStats struct {
+	RunType         string  `json:"run_type"`
+	Runs            int     `json:"runs"`
+	XP              int64   `json:"xp"`
+	Minutes         float64 `json:"minutes"`
+	XPPerMinute     float64 `json:"xp_per_minute"`
+	AverageXPPerRun int64   `json:"average_xp_per_run"`
+}
+
+// ========== XP EFFICIENCY API ==========
+
+// GetXPEfficiency compares XP per minute by area and by run type over all finished runs.
+// Runs without XP are skipped: records from older versions have none and runs at level 99 gain none.
+func (a *App) GetXPEfficiency() XPEfficiency {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	areas := make(map[string]*XPAreaStats)
+	runTypes := make(map[string]*XPRunTypeStats)
+	for _, record := range a.runRecords {
+		if record.XP <= 0 {
+			continue
+		}
+
+		runType, found := runTypes[record.RunType]
+		if !found {
+			runType = &XPRunTypeStats{RunType: record.RunType}
+			runTypes[record.RunType] = runType
+		}
+		runType.Runs++
+		runType.XP += record.XP
+		runType.Minutes += (time.Duration(record.DurationMs) * time.Millisecond).Minutes()
+
+		for areaName, xp := range record.AreaXP {
+			areaStats, found := areas[areaName]
+			if !found {
+				areaStats = &XPAreaStats{Area: areaName}
+				areas[areaName] = areaStats
+			}
+			areaStats.Runs++
+			areaStats.XP += xp
+		}
+		// Time is only counted for areas that gained XP, so idle areas do not dilute the rate
+		for areaName, spentMs := range record.Areas {
+			if areaStats, found := areas[areaName]; found && record.AreaXP[areaName] > 0 {
+				areaStats.Minutes += (time.Duration(spentMs) * time.Millisecond).Minutes()
+			}
+		}
+	}
+
+	efficiency := XPEfficiency{
+		Areas:    make([]XPAreaStats, 0, len(areas)),
+		RunTypes: make([]XPRunTypeStats, 0, len(runTypes)),
+	}
+	for _, areaStats := range areas {
+		if areaStats.Minutes > 0 {
+			areaStats.XPPerMinute = float64(areaStats.XP) / areaStats.Minutes
+		}
+		efficiency.Areas = append(efficiency.Areas, *areaStats)
+	}
+	for _, runType := range runTypes {
+		if runType.Minutes > 0 {
+			runType.XPPerMinute = float64(runType.XP) / runType.Minutes
+		}
+		runType.AverageXPPerRun = runType.XP / int64(runType.Runs)
+		efficiency.RunTypes = append(efficiency.RunTypes, *runType)
+	}
+
+	sort.Slice(efficiency.Areas, func(i, j int) bool {
+		if efficiency.Areas[i].XPPerMinute != efficiency.Areas[j].XPPerMinute {
+			return efficiency.Areas[i].XPPerMinute > efficiency.Areas[j].XPPerMinute
+		}
+		return efficiency.Areas[i].Area < efficiency.Areas[j].Area
+	})
+	sort.Slice(efficiency.RunTypes, func(i, j int) bool {
+		if efficiency.RunTypes[i].XPPerMinute != efficiency.RunTypes[j].XPPerMinute {
+			return efficiency.RunTypes[i].XPPerMinute > efficiency.RunTypes[j].XPPerMinute
+		}
+		return efficiency.RunTypes[i].RunType < efficiency.RunTypes[j].RunType
+	})
+	return efficiency
+}
@@ -0,0 +1,244 @@
+// xp_history.go - XP History & Level-Up Log for D2R Tracker
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ========== XP HISTORY STRUCTURES ==========
+
+// XPHistory is the unbounded XP log of a profile
+type XPHistory struct {
+	Runs       []XPRunEntry           `json:"runs"`
+	LevelUps   []LevelUpRecord        `json:"level_ups"`
+	InProgress map[string]*LevelTimer `json:"in_progress"` // Character -> level being played, updated in place every tick
+}
+
+type XPRunEntry struct {
+	RunIndex   int       `json:"run_index"`
+	Time       time.Time `json:"time"` // End of the run
+	Character  string    `json:"character,omitempty"`
+	Level      int       `json:"level"` // Level at the end of the run
+	XP         int64     `json:"xp"`
+	DurationMs int64     `json:"duration_ms"`
+}
+
+type LevelUpRecord struct {
+	Time       time.Time `json:"time"`
+	Character  string    `json:"character,omitempty"`
+	Level      int       `json:"level"` // Level reached
+	RunIndex   int       `json:"run_index"`
+	Area       string    `json:"area,omitempty"`
+	PlayTimeMs int64     `json:"play_time_ms"` // Time in game spent on the previous level
+	RealTimeMs int64     `json:"real_time_ms"` // Wall-clock time spent on the previous level
+	Runs       int       `json:"runs"`         // Runs started on the previous level
+	Complete   bool      `json:"complete"`     // The previous level was tracked from its start
+}
+
+// LevelTimer collects time and runs on the level a character is playing
+type LevelTimer struct {
+	Level      int       `json:"level"`
+	Since      time.Time `json:"since"`
+	PlayTimeMs int64     `json:"play_time_ms"`
+	Runs       int       `json:"runs"`
+	FromStart  bool      `json:"from_start"` // Started with a tracked level-up
+}
+
+// LevelingStep is one level of the leveling curve
+type LevelingStep struct {
+	Level       int       `json:"level"`
+	ReachedAt   time.Time `json:"reached_at"`   // Zero when the level was reached before tracking
+	CompletedAt time.Time `json:"completed_at"` // Zero while the level is in progress
+	PlayTimeMs  int64     `json:"play_time_ms"`
+	RealTimeMs  int64     `json:"real_time_ms"`
+	Runs        int       `json:"runs"`
+	LevelXP     int64     `json:"level_xp"`    // Experience from this level to the next
+	XPPerHour   float64   `json:"xp_per_hour"` // Level XP per hour in game (completed levels only)
+	Complete    bool      `json:"complete"`    // Tracked from start to finish
+	InProgress  bool      `json:"in_progress"`
+}
+
+// ========== XP HISTORY TRACKING ==========
+
+// recordLevelUps logs every level between from and to. Must be called with a.mu held.
+func (a *App) recordLevelUps(character string, from int, to int) {
+	now := time.Now()
+	for level := from + 1; level <= to; level++ {
+		timer := a.xpHistory.InProgress[character]
+		record := LevelUpRecord{
+			Time:      now,
+			Character: character,
+			Level:     level,
+			RunIndex:  a.currentRun,
+			Area:      a.getCurrentAreaName(),
+		}
+		if timer != nil && timer.Level == level-1 {
+			record.PlayTimeMs = timer.PlayTimeMs
+			record.Runs = timer.Runs
+			record.Complete = timer.FromStart
+			if !timer.Since.IsZero() {
+				record.RealTimeMs = now.Sub(timer.Since).Milliseconds()
+			}
+		}
+		a.xpHistory.LevelUps = append(a.xpHistory.LevelUps, record)
+		a.xpHistory.InProgress[character] = &LevelTimer{Level: level, Since: now, FromStart: true}
+
+		if record.Complete {
+			fmt.Printf("🎉 %s reached level %d after %s in game (%d runs)\n",
+				character, level, formatDuration(record.PlayTimeMs), record.Runs)
+		}
+	}
+}
+
+// trackLevelTime adds play time and the active run to the character's current level. Must be called with a.mu held.
+func (a *App) trackLevelTime(character string, level int, played time.Duration) {
+	if character == "" || level < 1 {
+		return
+	}
+	timer := a.xpHistory.InProgress[character]
+	if timer == nil || timer.Level != level {
+		// Level changed outside the tracker; time on this level is only partly known
+		timer = &LevelTimer{Level: level, Since: time.Now()}
+		a.xpHistory.InProgress[character] = timer
+	}
+	timer.PlayTimeMs += played.Milliseconds()
+	if a.runActive && !a.xpRunCounted {
+		timer.Runs++
+		a.xpRunCounted = true
+	}
+}
+
+// recordXPRun logs the XP of a finished run. Must be called with a.mu held.
+func (a *App) recordXPRun(duration time.Duration) {
+	a.xpHistory.Runs = append(a.xpHistory.Runs, XPRunEntry{
+		RunIndex:   a.currentRun,
+		Time:       time.Now(),
+		Character:  a.lastGameData.PlayerUnit.Name,
+		Level:      a.xpTracking.CurrentLevel,
+		XP:         a.xpTracking.XPThisRun,
+		DurationMs: duration.Milliseconds(),
+	})
+}
+
+// ensureXPHistory fills in missing parts of a loaded history. Must be called with a.mu held.
+func (a *App) ensureXPHistory() {
+	if a.xpHistory.Runs == nil {
+		a.xpHistory.Runs = make([]XPRunEntry, 0)
+	}
+	if a.xpHistory.LevelUps == nil {
+		a.xpHistory.LevelUps = make([]LevelUpRecord, 0)
+	}
+	if a.xpHistory.InProgress == nil {
+		a.xpHistory.InProgress = make(map[string]*LevelTimer)
+	}
+	for character, timer := range a.xpHistory.InProgress {
+		if timer == nil {
+			delete(a.xpHistory.InProgress, character)
+		}
+	}
+}
+
+// snapshot copies the history for encoding after a.mu is released. Must be called with a.mu held.
+func (h XPHistory) snapshot() XPHistory {
+	inProgress := make(map[string]*LevelTimer, len(h.InProgress))
+	for character, timer := range h.InProgress {
+		copied := *timer
+		inProgress[character] = &copied
+	}
+	h.InProgress = inProgress
+	return h
+}
+
+// ========== XP HISTORY API ==========
+
+// GetXPHistory returns the newest run XP entries first; limit <= 0 returns all
+func (a *App) GetXPHistory(limit int) []XPRunEntry {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	runs := make([]XPRunEntry, 0, len(a.xpHistory.Runs))
+	for i := len(a.xpHistory.Runs) - 1; i >= 0; i-- {
+		if limit > 0 && len(runs) >= limit {
+			break
+		}
+		runs = append(runs, a.xpHistory.Runs[i])
+	}
+	return runs
+}
+
+// GetLevelUps returns all level-ups, newest first
+func (a *App) GetLevelUps() []LevelUpRecord {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	levelUps := make([]LevelUpRecord, 0, len(a.xpHistory.LevelUps))
+	for i := len(a.xpHistory.LevelUps) - 1; i >= 0; i-- {
+		levelUps = append(levelUps, a.xpHistory.LevelUps[i])
+	}
+	return levelUps
+}
+
+// GetLevelingCurve returns time and runs per level of a character, lowest level first.
+// An empty character uses the character currently (or last) played.
+func (a *App) GetLevelingCurve(character string) []LevelingStep {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if character == "" {
+		character = a.lastGameData.PlayerUnit.Name
+	}
+	if character == "" && len(a.xpHistory.LevelUps) > 0 {
+		character = a.xpHistory.LevelUps[len(a.xpHistory.LevelUps)-1].Character
+	}
+
+	steps := make(map[int]*LevelingStep)
+	step := func(level int) *LevelingStep {
+		s, found := steps[level]
+		if !found {
+			s = &LevelingStep{Level: level, LevelXP: a.getXPForLevel(level+1) - a.getXPForLevel(level)}
+			if level >= maxPlayerLevel {
+				s.LevelXP = 0
+			}
+			steps[level] = s
+		}
+		return s
+	}
+
+	for _, record := range a.xpHistory.LevelUps {
+		if record.Character != character {
+			continue
+		}
+		step(record.Level).ReachedAt = record.Time
+
+		previous := step(record.Level - 1)
+		previous.CompletedAt = record.Time
+		previous.PlayTimeMs = record.PlayTimeMs
+		previous.RealTimeMs = record.RealTimeMs
+		previous.Runs = record.Runs
+		previous.Complete = record.Complete
+		if record.Complete && record.PlayTimeMs > 0 {
+			previous.XPPerHour = float64(previous.LevelXP) / (time.Duration(record.PlayTimeMs) * time.Millisecond).Hours()
+		}
+	}
+
+	if timer := a.xpHistory.InProgress[character]; timer != nil {
+		current := step(timer.Level)
+		current.InProgress = true
+		current.PlayTimeMs = timer.PlayTimeMs
+		current.Runs = timer.Runs
+		if !timer.Since.IsZero() {
+			current.RealTimeMs = time.Since(timer.Since).Milliseconds()
+		}
+	}
+
+	curve := make([]LevelingStep, 0, len(steps))
+	for _, s := range steps {
+		if s.Level >= 1 {
+			curve = append(curve, *s)
+		}
+	}
+	sort.Slice(curve, func(i, j int) bool { return curve[i].Level < curve[j].Level })
+	return curve
+}
@@ -0,0 +1,125 @@
+// xp_model.go - Experience Model for D2R Tracker
+package main
+
+import (
+	"fmt"
+)
+
+const maxPlayerLevel = 99
+
+// experienceTable is the total experience needed to reach each level (index = level),
+// taken from the game's Experience.txt. D2R uses the same table for every class.
+var experienceTable = [maxPlayerLevel + 1]int64{
+	0, // Unused
+	0, 500, 1500, 3750, 7875, 14175, 22680, 32886, 44396, 57715,
+	72144, 90180, 112725, 140906, 176132, 220165, 275207, 344008, 430010, 537513,
+	671891, 839864, 1049830, 1312287, 1640359, 2050449, 2563061, 3203826, 3902260, 4663553,
+	5493363, 6397855, 7383752, 8458379, 9629723, 10906488, 12298162, 13815086, 15468534, 17270791,
+	19235252, 21376515, 23710491, 26254525, 29027522, 32050088, 35344686, 38935798, 42850109, 47116709,
+	51767302, 56836449, 62361819, 68384473, 74949165, 82104680, 89904203, 98405684, 107672194, 117772329,
+	128775911, 140765472, 153830290, 168068344, 183587256, 200504400, 218947089, 239049096, 260960897, 284846104,
+	310882535, 339264137, 370203220, 403929650, 440693455, 480766125, 524442657, 572044069, 623919811, 680450316,
+	742049893, 809170002, 882307722, 962003659, 1048844864, 1143471627, 1246578978, 1358918937, 1481304888, 1614619470,
+	1759811546, 1917908590, 2090040066, 2277453716, 2481531438, 2703799802, 2945944000, 3209839052, 3520485254,
+}
+
+// XPLevel describes one level of the experience model
+type XPLevel struct {
+	Level   int   `json:"level"`
+	TotalXP int64 `json:"total_xp"` // Experience needed to reach this level
+	LevelXP int64 `json:"level_xp"` // Experience from this level to the next (0 at 99)
+	XPTo99  int64 `json:"xp_to_99"` // Experience from the start of this level to 99
+}
+
+// ========== MODEL VALIDATION ==========
+
+// validateExperienceTable checks the embedded table once at startup
+func validateExperienceTable() error {
+	if experienceTable[1] != 0 {
+		return fmt.Errorf("level 1 must start at 0 XP, got %d", experienceTable[1])
+	}
+	if experienceTable[maxPlayerLevel] != 3520485254 {
+		return fmt.Errorf("level 99 must start at 3520485254 XP, got %d", experienceTable[maxPlayerLevel])
+	}
+	for level := 2; level <= maxPlayerLevel; level++ {
+		if experienceTable[level] <= experienceTable[level-1] {
+			return fmt.Errorf("XP for level %d (%d) is not above level %d (%d)",
+				level, experienceTable[level], level-1, experienceTable[level-1])
+		}
+		// Every level takes at least as long as the one before
+		if level >= 3 && experienceTable[level]-experienceTable[level-1] < experienceTable[level-1]-experienceTable[level-2] {
+			return fmt.Errorf("level %d needs less XP than level %d", level-1, level-2)
+		}
+	}
+	return nil
+}
+
+// ========== MODEL QUERIES ==========
+
+// getXPForLevel returns the total experience needed to reach a level (0 if out of range)
+func (a *App) getXPForLevel(level int) int64 {
+	if level < 1 || level > maxPlayerLevel {
+		return 0
+	}
+	return experienceTable[level]
+}
+
+// getXPToNextLevel returns the exact experience still missing for the next level
+func (a *App) getXPToNextLevel(currentXP int64, currentLevel int) int64 {
+	if currentLevel < 1 || currentLevel >= maxPlayerLevel {
+		return 0
+	}
+	missing := experienceTable[currentLevel+1] - currentXP
+	if missing < 0 {
+		return 0
+	}
+	return missing
+}
+
+// getLevelProgress returns how far the player is into the current level in percent
+func (a *App) getLevelProgress(currentXP int64, currentLevel int) float64 {
+	if currentLevel < 1 {
+		return 0
+	}
+	if currentLevel >= maxPlayerLevel {
+		return 100
+	}
+	levelStart := experienceTable[currentLevel]
+	levelXP := experienceTable[currentLevel+1] - levelStart
+	progress := float64(currentXP-levelStart) / float64(levelXP) * 100
+	if progress < 0 {
+		return 0
+	}
+	if progress > 100 {
+		return 100
+	}
+	return progress
+}
+
+// getXPToLevel99 returns the experience still missing for level 99
+func (a *App) getXPToLevel99(currentXP int64) int64 {
+	missing := experienceTable[maxPlayerLevel] - currentXP
+	if missing < 0 {
+		return 0
+	}
+	return missing
+}
+
+// ========== MODEL API ==========
+
+// GetXPModel returns the experience model for every level
+func (a *App) GetXPModel() []XPLevel {
+	levels := make([]XPLevel, 0, maxPlayerLevel)
+	for level := 1; level <= maxPlayerLevel; level++ {
+		info := XPLevel{
+			Level:   level,
+			TotalXP: experienceTable[level],
+			XPTo99:  experienceTable[maxPlayerLevel] - experienceTable[level],
+		}
+		if level < maxPlayerLevel {
+			info.LevelXP = experienceTable[level+1] - experienceTable[level]
+		}
+		levels = append(levels, info)
+	}
+	return levels
+}
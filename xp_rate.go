@@ -0,0 +1,253 @@
+// xp_rate.go - Rolling XP Rates & Level Forecast for D2R Tracker
+package main
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	defaultXPWindowMinutes = 10
+	defaultXPWindowRuns    = 10
+	maxXPWindowMinutes     = 120
+	maxXPWindowRuns        = 100
+	// Minutes with less play time than this are too short to be a rate sample
+	minXPBucketActive = 30 * time.Second
+	// z-score of the 90% forecast range
+	xpForecastZ = 1.645
+)
+
+// ========== XP RATE STRUCTURES ==========
+
+// XPRateSettings are the rolling windows for XP rates (stored per profile)
+type XPRateSettings struct {
+	WindowMinutes int `json:"window_minutes"`
+	WindowRuns    int `json:"window_runs"`
+}
+
+type XPRates struct {
+	WindowMinutes  int        `json:"window_minutes"`
+	WindowRuns     int        `json:"window_runs"`
+	RecentPerHour  float64    `json:"recent_per_hour"`  // XP per hour in game over the last WindowMinutes
+	RunsPerHour    float64    `json:"runs_per_hour"`    // XP per hour of run time over the last WindowRuns runs
+	SessionPerHour float64    `json:"session_per_hour"` // Session XP per hour in game
+	RunsUsed       int        `json:"runs_used"`        // Runs with XP in the run window
+	Basis          string     `json:"basis"`            // Rate the forecast uses: "runs", "recent", "session" or "" (none)
+	Confidence     string     `json:"confidence"`       // "high", "medium", "low" or "none"
+	NextLevel      XPForecast `json:"next_level"`
+	Level99        XPForecast `json:"level_99"`
+}
+
+// XPForecast is the expected play time until an XP target, with a 90% range
+type XPForecast struct {
+	XPNeeded  int64   `json:"xp_needed"`
+	Hours     float64 `json:"hours"`      // 0 = no forecast
+	HoursLow  float64 `json:"hours_low"`  // Fast end of the range (0 = unknown)
+	HoursHigh float64 `json:"hours_high"` // Slow end of the range (0 = unknown or open-ended)
+}
+
+// xpBucket is one wall-clock minute of XP gain and play time
+type xpBucket struct {
+	start  time.Time
+	xp     int64
+	active time.Duration
+}
+
+// ========== XP SAMPLING ==========
+
+// sampleXP adds play time and gained XP to the current minute and returns the play time added.
+// Must be called with a.mu held.
+func (a *App) sampleXP(gained int64) time.Duration {
+	now := time.Now()
+	elapsed := now.Sub(a.lastXPTick)
+	a.lastXPTick = now
+
+	minute := now.Truncate(time.Minute)
+	if last := len(a.xpBuckets) - 1; last < 0 || !a.xpBuckets[last].start.Equal(minute) {
+		a.xpBuckets = append(a.xpBuckets, xpBucket{start: minute})
+	}
+	bucket := &a.xpBuckets[len(a.xpBuckets)-1]
+	bucket.xp += gained
+
+	// Ignore long gaps (menus, loading screens, stalled reads)
+	if elapsed <= 0 || elapsed > time.Second {
+		elapsed = 0
+	}
+	bucket.active += elapsed
+	a.xpActiveTime += elapsed
+
+	cutoff := minute.Add(-maxXPWindowMinutes * time.Minute)
+	for len(a.xpBuckets) > 0 && a.xpBuckets[0].start.Before(cutoff) {
+		a.xpBuckets = a.xpBuckets[1:]
+	}
+	return elapsed
+}
+
+// resetXPSampling forgets the rolling XP samples of the session. Must be called with a.mu held.
+func (a *App) resetXPSampling() {
+	a.xpBuckets = nil
+	a.xpActiveTime = 0
+	a.lastXPTick = time.Time{}
+}
+
+// ========== XP RATES & FORECAST ==========
+
+// getXPRates computes the rolling rates and the forecast from the best available rate.
+// Must be called with a.mu held.
+func (a *App) getXPRates() XPRates {
+	settings := a.xpRateSettings.normalized()
+	rates := XPRates{
+		WindowMinutes: settings.WindowMinutes,
+		WindowRuns:    settings.WindowRuns,
+		Confidence:    "none",
+	}
+
+	// Session: XP per hour actually spent in game
+	if a.xpActiveTime >= time.Minute {
+		rates.SessionPerHour = float64(a.xpTracking.SessionXPGained) / a.xpActiveTime.Hours()
+	}
+
+	// Recent minutes; every minute with enough play time is one sample
+	var recentXP int64
+	var recentActive time.Duration
+	var minuteRates []float64
+	cutoff := time.Now().Add(-time.Duration(settings.WindowMinutes) * time.Minute)
+	for _, bucket := range a.xpBuckets {
+		if bucket.start.Add(time.Minute).Before(cutoff) {
+			continue
+		}
+		recentXP += bucket.xp
+		recentActive += bucket.active
+		if bucket.active >= minXPBucketActive {
+			minuteRates = append(minuteRates, float64(bucket.xp)/bucket.active.Hours())
+		}
+	}
+	if recentActive >= time.Minute {
+		rates.RecentPerHour = float64(recentXP) / recentActive.Hours()
+	}
+
+	// Recent runs; runs without XP are skipped because records from older versions have none
+	var runXP int64
+	var runTime time.Duration
+	var runRates []float64
+	for i := len(a.runRecords) - 1; i >= 0 && len(runRates) < settings.WindowRuns; i-- {
+		record := a.runRecords[i]
+		if record.XP <= 0 || record.DurationMs <= 0 {
+			continue
+		}
+		duration := time.Duration(record.DurationMs) * time.Millisecond
+		runXP += record.XP
+		runTime += duration
+		runRates = append(runRates, float64(record.XP)/duration.Hours())
+	}
+	rates.RunsUsed = len(runRates)
+	if runTime > 0 {
+		rates.RunsPerHour = float64(runXP) / runTime.Hours()
+	}
+
+	var rate float64
+	var samples []float64
+	switch {
+	case rates.RunsUsed >= 3 && rates.RunsPerHour > 0:
+		rates.Basis, rate, samples = "runs", rates.RunsPerHour, runRates
+	case rates.RecentPerHour > 0:
+		rates.Basis, rate, samples = "recent", rates.RecentPerHour, minuteRates
+	case rates.SessionPerHour > 0:
+		rates.Basis, rate = "session", rates.SessionPerHour
+	default:
+		return rates
+	}
+
+	spread := rateSpread(samples)
+	switch {
+	case len(samples) >= 10:
+		rates.Confidence = "high"
+	case len(samples) >= 3:
+		rates.Confidence = "medium"
+	default:
+		rates.Confidence = "low"
+	}
+
+	rates.NextLevel = forecastXP(a.xpTracking.XPToNextLevel, rate, spread)
+	rates.Level99 = forecastXP(a.xpTracking.XPToLevel99, rate, spread)
+	return rates
+}
+
+// rateSpread is the half width of the 90% range of the mean rate (0 with fewer than 3 samples)
+func rateSpread(samples []float64) float64 {
+	if len(samples) < 3 {
+		return 0
+	}
+	var sum float64
+	for _, sample := range samples {
+		sum += sample
+	}
+	mean := sum / float64(len(samples))
+
+	var squares float64
+	for _, sample := range samples {
+		squares += (sample - mean) * (sample - mean)
+	}
+	stdDev := math.Sqrt(squares / float64(len(samples)-1))
+	return xpForecastZ * stdDev / math.Sqrt(float64(len(samples)))
+}
+
+func forecastXP(needed int64, rate float64, spread float64) XPForecast {
+	forecast := XPForecast{XPNeeded: needed}
+	if needed <= 0 || rate <= 0 {
+		return forecast
+	}
+	forecast.Hours = float64(needed) / rate
+	if spread > 0 {
+		forecast.HoursLow = float64(needed) / (rate + spread)
+		if rate > spread {
+			forecast.HoursHigh = float64(needed) / (rate - spread)
+		}
+	}
+	return forecast
+}
+
+func (s XPRateSettings) normalized() XPRateSettings {
+	if s.WindowMinutes <= 0 {
+		s.WindowMinutes = defaultXPWindowMinutes
+	}
+	if s.WindowMinutes > maxXPWindowMinutes {
+		s.WindowMinutes = maxXPWindowMinutes
+	}
+	if s.WindowRuns <= 0 {
+		s.WindowRuns = defaultXPWindowRuns
+	}
+	if s.WindowRuns > maxXPWindowRuns {
+		s.WindowRuns = maxXPWindowRuns
+	}
+	return s
+}
+
+// ========== XP RATE API ==========
+
+func (a *App) GetXPRates() XPRates {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return a.getXPRates()
+}
+
+// SetXPRateWindows changes the rolling windows; values are clamped to 1-120 minutes and 1-100 runs
+func (a *App) SetXPRateWindows(minutes int, runs int) XPRateSettings {
+	if minutes < 1 {
+		minutes = 1
+	}
+	if runs < 1 {
+		runs = 1
+	}
+
+	a.mu.Lock()
+	a.xpRateSettings = XPRateSettings{WindowMinutes: minutes, WindowRuns: runs}.normalized()
+	settings := a.xpRateSettings
+	a.mu.Unlock()
+
+	fmt.Printf("📈 XP rate windows: last %d minutes, last %d runs\n", settings.WindowMinutes, settings.WindowRuns)
+	a.SaveCurrentProfile()
+	return settings
+}